@@ -13,7 +13,7 @@ require (
 	github.com/hashicorp/terraform-plugin-log v0.7.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.24.1
 	github.com/robfig/cron/v3 v3.0.1
-	github.com/scaleway/scaleway-sdk-go v1.0.0-beta.10.0.20221212155715-1af141c8883f
+	github.com/scaleway/scaleway-sdk-go v1.0.0-beta.13
 	github.com/stretchr/testify v1.8.1
 )
 
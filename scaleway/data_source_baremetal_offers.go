@@ -0,0 +1,185 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayBaremetalOffers() *schema.Resource {
+	// Offers share the computed attributes of the singular data source
+	offerSchema := datasourceSchemaFromResourceSchema(dataSourceScalewayBaremetalOffer().Schema)
+	for _, key := range []string{"offer_id", "zone", "include_disabled"} {
+		delete(offerSchema, key)
+	}
+	offerSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the offer",
+	}
+	offerSchema["enable"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "True if the offer is currently available",
+	}
+	offerSchema["price_per_hour"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "Price of the offer per hour",
+	}
+	offerSchema["price_per_month"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "Price of the offer per month",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayBaremetalOffersRead,
+		Schema: map[string]*schema.Schema{
+			"subscription_period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					baremetal.OfferSubscriptionPeriodHourly.String(),
+					baremetal.OfferSubscriptionPeriodMonthly.String(),
+				}, false),
+				Description: "Offers with this period of subscription are listed",
+			},
+			"include_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include disabled offers",
+			},
+			"min_cpu_core_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Offers with at least this number of CPU cores are listed",
+			},
+			"min_memory": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Offers with at least this memory capacity, in bytes, are listed",
+			},
+			"disk_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Offers with at least one disk of this type (e.g. SSD, NVMe, HDD) are listed",
+			},
+			"min_disk_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Offers with at least this total disk capacity, in bytes, are listed",
+			},
+			"min_bandwidth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Offers with at least this bandwidth, in bits/s, are listed",
+			},
+			"max_price_per_hour": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Offers with at most this price per hour are listed",
+			},
+			"max_price_per_month": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Offers with at most this price per month are listed",
+			},
+			"stock": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						baremetal.OfferStockAvailable.String(),
+						baremetal.OfferStockLow.String(),
+						baremetal.OfferStockEmpty.String(),
+					}, false),
+				},
+				Optional:    true,
+				Description: "Offers with one of these stock statuses are listed",
+			},
+			"offers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The offers matching the filters",
+				Elem: &schema.Resource{
+					Schema: offerSchema,
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayBaremetalOffersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zone, err := baremetalAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := baremetalAPI.ListOffers(&baremetal.ListOffersRequest{
+		Zone:               zone,
+		SubscriptionPeriod: baremetal.OfferSubscriptionPeriod(d.Get("subscription_period").(string)),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := &baremetalOfferFilter{
+		IncludeDisabled:  d.Get("include_disabled").(bool),
+		MinCPUCoreCount:  uint32(d.Get("min_cpu_core_count").(int)),
+		MinMemory:        uint64(d.Get("min_memory").(int)),
+		DiskType:         d.Get("disk_type").(string),
+		MinDiskCapacity:  uint64(d.Get("min_disk_capacity").(int)),
+		MinBandwidth:     uint64(d.Get("min_bandwidth").(int)),
+		MaxPricePerHour:  d.Get("max_price_per_hour").(float64),
+		MaxPricePerMonth: d.Get("max_price_per_month").(float64),
+	}
+	for _, stock := range expandStrings(d.Get("stock")) {
+		filter.Stocks = append(filter.Stocks, baremetal.OfferStock(stock))
+	}
+
+	offers := []map[string]interface{}(nil)
+	for _, offer := range res.Offers {
+		if !filter.matches(offer) {
+			continue
+		}
+
+		rawOffer := map[string]interface{}{
+			"id":                  newZonedIDString(zone, offer.ID),
+			"name":                offer.Name,
+			"subscription_period": offer.SubscriptionPeriod.String(),
+			"enable":              offer.Enable,
+			"bandwidth":           int(offer.Bandwidth),
+			"commercial_range":    offer.CommercialRange,
+			"cpu":                 flattenBaremetalCPUs(offer.CPUs),
+			"disk":                flattenBaremetalDisks(offer.Disks),
+			"memory":              flattenBaremetalMemory(offer.Memories),
+			"stock":               offer.Stock.String(),
+		}
+		if offer.PricePerHour != nil {
+			rawOffer["price_per_hour"] = offer.PricePerHour.ToFloat()
+		}
+		if offer.PricePerMonth != nil {
+			rawOffer["price_per_month"] = offer.PricePerMonth.ToFloat()
+		}
+		offers = append(offers, rawOffer)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("offers", offers)
+	_ = d.Set("zone", zone)
+
+	return nil
+}
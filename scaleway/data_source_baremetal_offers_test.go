@@ -0,0 +1,52 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaremetalOfferFilterMatches(t *testing.T) {
+	offer := &baremetal.Offer{
+		Enable:    true,
+		Stock:     baremetal.OfferStockLow,
+		Bandwidth: 1000000000,
+		CPUs: []*baremetal.CPU{
+			{CoreCount: 8},
+			{CoreCount: 8},
+		},
+		Memories: []*baremetal.Memory{
+			{Capacity: 32 * scw.GB},
+			{Capacity: 32 * scw.GB},
+		},
+		Disks: []*baremetal.Disk{
+			{Type: "NVMe", Capacity: 1 * scw.TB},
+			{Type: "NVMe", Capacity: 1 * scw.TB},
+		},
+		PricePerHour: scw.NewMoneyFromFloat(0.5, "EUR", 2),
+	}
+
+	assert.True(t, (&baremetalOfferFilter{}).matches(offer))
+	assert.True(t, (&baremetalOfferFilter{
+		MinCPUCoreCount: 16,
+		MinMemory:       uint64(64 * scw.GB),
+		DiskType:        "nvme",
+		MinDiskCapacity: uint64(2 * scw.TB),
+		MinBandwidth:    1000000000,
+		MaxPricePerHour: 0.5,
+		Stocks:          []baremetal.OfferStock{baremetal.OfferStockAvailable, baremetal.OfferStockLow},
+	}).matches(offer))
+
+	assert.False(t, (&baremetalOfferFilter{MinCPUCoreCount: 17}).matches(offer))
+	assert.False(t, (&baremetalOfferFilter{MinMemory: uint64(128 * scw.GB)}).matches(offer))
+	assert.False(t, (&baremetalOfferFilter{DiskType: "HDD"}).matches(offer))
+	assert.False(t, (&baremetalOfferFilter{MaxPricePerHour: 0.4}).matches(offer))
+	assert.False(t, (&baremetalOfferFilter{MaxPricePerMonth: 100}).matches(offer))
+	assert.False(t, (&baremetalOfferFilter{Stocks: []baremetal.OfferStock{baremetal.OfferStockAvailable}}).matches(offer))
+
+	offer.Enable = false
+	assert.False(t, (&baremetalOfferFilter{}).matches(offer))
+	assert.True(t, (&baremetalOfferFilter{IncludeDisabled: true}).matches(offer))
+}
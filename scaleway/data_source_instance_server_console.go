@@ -0,0 +1,98 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceServerConsole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceServerConsoleRead,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the server",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the server",
+			},
+			"state_detail": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The detailed state of the server",
+			},
+			"boot_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The boot type of the server",
+			},
+			"cloud_init_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cloud-init status reported in the cloud-init-status user data key",
+			},
+			"cloud_init_done": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if cloud-init reported it is over, successfully or not",
+			},
+			"cloud_init_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The failure reported by cloud-init",
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayInstanceServerConsoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zonedID := datasourceNewZonedID(d.Get("server_id"), zone)
+	zone, serverID, err := parseZonedID(zonedID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetServer(&instance.GetServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInitStatus, err := getInstanceServerCloudInitStatus(ctx, instanceAPI, zone, serverID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cloudInitDone, cloudInitErr := parseInstanceServerCloudInitStatus(cloudInitStatus)
+
+	d.SetId(zonedID)
+	_ = d.Set("server_id", zonedID)
+	_ = d.Set("state", res.Server.State.String())
+	_ = d.Set("state_detail", res.Server.StateDetail)
+	_ = d.Set("boot_type", res.Server.BootType.String())
+	_ = d.Set("cloud_init_status", cloudInitStatus)
+	_ = d.Set("cloud_init_done", cloudInitDone)
+	if cloudInitErr != nil {
+		_ = d.Set("cloud_init_error", cloudInitErr.Error())
+	} else {
+		_ = d.Set("cloud_init_error", "")
+	}
+	_ = d.Set("zone", zone)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstanceServerCloudInitStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		done   bool
+		err    string
+	}{
+		{status: "", done: false},
+		{status: "running", done: false},
+		{status: "done", done: true},
+		{status: "error", done: true, err: "cloud-init failed: no details reported"},
+		{status: "error: apt-get install failed", done: true, err: "cloud-init failed: apt-get install failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			done, err := parseInstanceServerCloudInitStatus(tt.status)
+			assert.Equal(t, tt.done, done)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayLbBackendStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayLbBackendStatsRead,
+		Schema: map[string]*schema.Schema{
+			"lb_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The load-balancer ID",
+			},
+			"backend_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "Only servers of this backend are listed",
+			},
+			"backend_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "State of the servers behind the load-balancer",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the load-balancer instance handling the server",
+						},
+						"backend_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The backend ID of the server",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IPv4 or IPv6 address of the server",
+						},
+						"server_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server operational state (stopped/starting/running/stopping)",
+						},
+						"server_state_changed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time since last operational change",
+						},
+						"last_health_check_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last health check status (unknown/neutral/failed/passed/condpass)",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayLbBackendStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lbZonedID := datasourceNewZonedID(d.Get("lb_id"), zone)
+	zone, lbID, err := parseZonedID(lbZonedID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := lbAPI.ListBackendStats(&lbSDK.ZonedAPIListBackendStatsRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backendID := expandID(d.Get("backend_id"))
+	stats := make([]*lbSDK.BackendServerStats, 0, len(res.BackendServersStats))
+	for _, stat := range res.BackendServersStats {
+		if backendID == "" || stat.BackendID == backendID {
+			stats = append(stats, stat)
+		}
+	}
+
+	d.SetId(lbZonedID)
+	_ = d.Set("lb_id", lbZonedID)
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("backend_servers", flattenLbBackendServerStats(stats, zone))
+
+	return nil
+}
@@ -20,8 +20,11 @@ func dataSourceScalewayRDBPrivilege() *schema.Resource {
 }
 
 func dataSourceScalewayRDBPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	instanceID, _ := d.Get("instance_id").(string)
+	region, instanceID, err := parseRegionalID(d.Get("instance_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	d.SetId(instanceID)
+	d.SetId(resourceScalewayRdbPrivilegeID(region, instanceID, d.Get("database_name").(string), d.Get("user_name").(string)))
 	return resourceScalewayRdbPrivilegeRead(ctx, d, meta)
 }
@@ -0,0 +1,119 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayVPCPublicGatewayDHCPEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayVPCPublicGatewayDHCPEntriesRead,
+		Schema: map[string]*schema.Schema{
+			"gateway_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the gateway network",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					vpcgw.DHCPEntryTypeReservation.String(),
+					vpcgw.DHCPEntryTypeLease.String(),
+				}, false),
+				Description: "Only entries of this type are listed (reservation or lease)",
+			},
+			"mac_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsMACAddress,
+				Description:  "Only entries with this MAC address are listed",
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DHCP entries of the gateway network",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the entry",
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MAC address of the client machine",
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address assigned to the client machine",
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the client machine",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The entry type, either reservation or lease",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The entry creation date",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The entry last modification date",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayVPCPublicGatewayDHCPEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayNetworkZonedID := datasourceNewZonedID(d.Get("gateway_network_id"), zone)
+	zone, gatewayNetworkID, err := parseZonedID(gatewayNetworkZonedID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &vpcgw.ListDHCPEntriesRequest{
+		Zone:             zone,
+		GatewayNetworkID: &gatewayNetworkID,
+		Type:             vpcgw.DHCPEntryType(d.Get("type").(string)),
+	}
+	if macAddress, ok := d.GetOk("mac_address"); ok {
+		req.MacAddress = expandStringPtr(macAddress)
+	}
+
+	res, err := vpcgwAPI.ListDHCPEntries(req, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(gatewayNetworkZonedID)
+	_ = d.Set("gateway_network_id", gatewayNetworkZonedID)
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("entries", flattenVPCPublicGatewayDHCPEntries(res.DHCPEntries, zone))
+
+	return nil
+}
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,3 +58,20 @@ func waitForAppleSiliconServer(ctx context.Context, api *applesilicon.API, zone
 
 	return server, err
 }
+
+// appleSiliconServerDeletableError returns an error if the server can't be deleted yet because of the Apple licence.
+func appleSiliconServerDeletableError(deletableAt string, now time.Time) error {
+	if deletableAt == "" {
+		return nil
+	}
+
+	deletableAtTime, err := time.Parse(time.RFC3339, deletableAt)
+	if err != nil {
+		return err
+	}
+	if now.Before(deletableAtTime) {
+		return fmt.Errorf("apple silicon server can't be deleted before %s due to Apple licence, %s from now", deletableAt, deletableAtTime.Sub(now).Round(time.Minute))
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -15,6 +17,11 @@ const (
 	baremetalServerRetryFuncTimeout = baremetalServerWaitForTimeout + time.Minute // some RetryFunc are calling a WaitFor
 	defaultBaremetalServerTimeout   = baremetalServerRetryFuncTimeout + time.Minute
 	baremetalRetryInterval          = 5 * time.Second
+
+	defaultBaremetalBMCAccessTimeout = 10 * time.Minute
+
+	baremetalServerStateStarted = "started"
+	baremetalServerStateStopped = "stopped"
 )
 
 // instanceAPIWithZone returns a new baremetal API and the zone for a Create request
@@ -309,3 +316,170 @@ func baremetalCompareOptions(slice1, slice2 []*baremetal.ServerOption) []*bareme
 	}
 	return diff
 }
+
+// flattenBaremetalServerState returns the state of a server from its status, or an empty string for transient statuses.
+func flattenBaremetalServerState(status baremetal.ServerStatus) string {
+	switch status {
+	case baremetal.ServerStatusReady:
+		return baremetalServerStateStarted
+	case baremetal.ServerStatusStopped:
+		return baremetalServerStateStopped
+	default:
+		return ""
+	}
+}
+
+// baremetalServerAction is the power action to run on a server to reach a state.
+type baremetalServerAction string
+
+const (
+	baremetalServerActionNone   baremetalServerAction = ""
+	baremetalServerActionStart  baremetalServerAction = "start"
+	baremetalServerActionStop   baremetalServerAction = "stop"
+	baremetalServerActionReboot baremetalServerAction = "reboot"
+)
+
+// baremetalServerStateAction returns the action to run on a server with the given status to reach a state,
+// a started server is rebooted when its boot type has to be applied.
+func baremetalServerStateAction(status baremetal.ServerStatus, state string, reboot bool) baremetalServerAction {
+	switch {
+	case state == baremetalServerStateStopped && status != baremetal.ServerStatusStopped:
+		return baremetalServerActionStop
+	case state == baremetalServerStateStarted && status == baremetal.ServerStatusStopped:
+		return baremetalServerActionStart
+	case state == baremetalServerStateStarted && reboot:
+		return baremetalServerActionReboot
+	default:
+		return baremetalServerActionNone
+	}
+}
+
+// baremetalServerReachState starts, stops or reboots a server, see baremetalServerStateAction.
+// The server must be in a stable status.
+func baremetalServerReachState(ctx context.Context, api *baremetal.API, server *baremetal.Server, state string, bootType baremetal.ServerBootType, reboot bool, timeout time.Duration) error {
+	zone, serverID := server.Zone, server.ID
+
+	var err error
+	switch baremetalServerStateAction(server.Status, state, reboot) {
+	case baremetalServerActionStop:
+		_, err = api.StopServer(&baremetal.StopServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+	case baremetalServerActionStart:
+		_, err = api.StartServer(&baremetal.StartServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+			BootType: bootType,
+		}, scw.WithContext(ctx))
+	case baremetalServerActionReboot:
+		_, err = api.RebootServer(&baremetal.RebootServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+			BootType: bootType,
+		}, scw.WithContext(ctx))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForBaremetalServer(ctx, api, zone, serverID, timeout)
+	return err
+}
+
+// validateBaremetalServerBootType rejects boot type changes on a stopped server: the API only applies a boot type
+// when starting or rebooting the server, and reports the boot type of its last boot.
+func validateBaremetalServerBootType(diff *schema.ResourceDiff) error {
+	if diff.Get("state").(string) != baremetalServerStateStopped || !diff.HasChange("boot_type") {
+		return nil
+	}
+
+	bootType := diff.Get("boot_type").(string)
+	if diff.Id() == "" && bootType == baremetal.ServerBootTypeNormal.String() {
+		return nil
+	}
+
+	return fmt.Errorf("boot_type %s can't be applied to a stopped server, set state to %s to boot it", bootType, baremetalServerStateStarted)
+}
+
+// waitForBaremetalBMCAccess waits for the credentials of a BMC access to be available.
+func waitForBaremetalBMCAccess(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.BMCAccess, error) {
+	var bmcAccess *baremetal.BMCAccess
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := api.GetBMCAccess(&baremetal.GetBMCAccessRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if res.URL == "" {
+			return resource.RetryableError(fmt.Errorf("BMC access of server %s is not ready yet", serverID))
+		}
+
+		bmcAccess = res
+		return nil
+	})
+
+	return bmcAccess, err
+}
+
+// baremetalBMCAccessExpired returns true if the BMC access was closed by the API because it expired.
+func baremetalBMCAccessExpired(bmcAccess *baremetal.BMCAccess, now time.Time) bool {
+	return bmcAccess.ExpiresAt != nil && bmcAccess.ExpiresAt.Before(now)
+}
+
+// baremetalOfferFilter describes the filters of the scaleway_baremetal_offers data source, zero values match any offer.
+type baremetalOfferFilter struct {
+	IncludeDisabled  bool
+	MinCPUCoreCount  uint32
+	MinMemory        uint64
+	DiskType         string
+	MinDiskCapacity  uint64
+	MinBandwidth     uint64
+	MaxPricePerHour  float64
+	MaxPricePerMonth float64
+	Stocks           []baremetal.OfferStock
+}
+
+func (f *baremetalOfferFilter) matches(offer *baremetal.Offer) bool {
+	if !offer.Enable && !f.IncludeDisabled {
+		return false
+	}
+
+	cpuCoreCount := uint32(0)
+	for _, cpu := range offer.CPUs {
+		cpuCoreCount += cpu.CoreCount
+	}
+	memory := uint64(0)
+	for _, memoryModule := range offer.Memories {
+		memory += uint64(memoryModule.Capacity)
+	}
+	diskCapacity, hasDiskType := uint64(0), f.DiskType == ""
+	for _, disk := range offer.Disks {
+		diskCapacity += uint64(disk.Capacity)
+		hasDiskType = hasDiskType || strings.EqualFold(disk.Type, f.DiskType)
+	}
+	if cpuCoreCount < f.MinCPUCoreCount || memory < f.MinMemory || diskCapacity < f.MinDiskCapacity || !hasDiskType || offer.Bandwidth < f.MinBandwidth {
+		return false
+	}
+
+	if f.MaxPricePerHour > 0 && (offer.PricePerHour == nil || offer.PricePerHour.ToFloat() > f.MaxPricePerHour) {
+		return false
+	}
+	if f.MaxPricePerMonth > 0 && (offer.PricePerMonth == nil || offer.PricePerMonth.ToFloat() > f.MaxPricePerMonth) {
+		return false
+	}
+
+	if len(f.Stocks) == 0 {
+		return true
+	}
+	for _, stock := range f.Stocks {
+		if offer.Stock == stock {
+			return true
+		}
+	}
+	return false
+}
@@ -51,3 +51,70 @@ func waitFlexibleIP(ctx context.Context, api *flexibleip.API, zone scw.Zone, id
 		RetryInterval: &retryInterval,
 	}, scw.WithContext(ctx))
 }
+
+// duplicateFlexibleIPMACAddress duplicates the virtual MAC address of a flexible IP on another flexible IP attached to the same server.
+func duplicateFlexibleIPMACAddress(ctx context.Context, api *flexibleip.API, zone scw.Zone, fipID string, duplicateID string, timeout time.Duration) error {
+	_, err := waitFlexibleIP(ctx, api, zone, duplicateID, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.DuplicateMACAddr(&flexibleip.DuplicateMACAddrRequest{
+		Zone:               zone,
+		FipID:              duplicateID,
+		DuplicateFromFipID: fipID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = waitFlexibleIP(ctx, api, zone, duplicateID, timeout)
+	return err
+}
+
+// deleteFlexibleIPMACAddress removes the virtual MAC address of a flexible IP, a deleted flexible IP is ignored.
+func deleteFlexibleIPMACAddress(ctx context.Context, api *flexibleip.API, zone scw.Zone, fipID string, timeout time.Duration) error {
+	flexibleIP, err := waitFlexibleIP(ctx, api, zone, fipID, timeout)
+	if err != nil {
+		if is404Error(err) || is403Error(err) {
+			return nil
+		}
+		return err
+	}
+	if flexibleIP.MacAddress == nil {
+		return nil
+	}
+
+	err = api.DeleteMACAddr(&flexibleip.DeleteMACAddrRequest{
+		Zone:  zone,
+		FipID: fipID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) && !is403Error(err) {
+		return err
+	}
+
+	_, err = waitFlexibleIP(ctx, api, zone, fipID, timeout)
+	if err != nil && !is404Error(err) && !is403Error(err) {
+		return err
+	}
+
+	return nil
+}
+
+// findFlexibleIPByMACAddressID returns the flexible IP holding the given virtual MAC address, or nil if there is none.
+func findFlexibleIPByMACAddressID(ctx context.Context, api *flexibleip.API, zone scw.Zone, macAddressID string) (*flexibleip.FlexibleIP, error) {
+	res, err := api.ListFlexibleIPs(&flexibleip.ListFlexibleIPsRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, flexibleIP := range res.FlexibleIPs {
+		if flexibleIP.MacAddress != nil && flexibleIP.MacAddress.ID == macAddressID {
+			return flexibleIP, nil
+		}
+	}
+
+	return nil, nil
+}
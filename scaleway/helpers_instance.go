@@ -3,12 +3,17 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
@@ -35,6 +40,14 @@ const (
 	defaultInstanceSnapshotWaitTimeout = 1 * time.Hour
 
 	defaultInstanceImageTimeout = 1 * time.Hour
+
+	defaultInstanceServerGroupTimeout = 1 * time.Hour
+
+	// instanceServerCloudInitStatusKey is the user data key cloud-init scripts report their status to
+	instanceServerCloudInitStatusKey = "cloud-init-status"
+
+	// maxInstanceSecurityGroupRules is the maximum number of rules the api accepts in a security group
+	maxInstanceSecurityGroupRules = 100
 )
 
 // instanceAPIWithZone returns a new instance API and the zone for a Create request
@@ -439,6 +452,64 @@ func waitForInstanceSnapshot(ctx context.Context, api *instance.API, zone scw.Zo
 	return snapshot, err
 }
 
+// exportInstanceSnapshot exports a snapshot as a qcow2 object in a bucket of the snapshot region and waits for the export to finish.
+func exportInstanceSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, snapshotID string, bucket string, key string, timeout time.Duration) error {
+	_, err := waitForInstanceSnapshot(ctx, api, zone, snapshotID, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.ExportSnapshot(&instance.ExportSnapshotRequest{
+		Zone:       zone,
+		SnapshotID: snapshotID,
+		Bucket:     bucket,
+		Key:        key,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := waitForInstanceSnapshot(ctx, api, zone, snapshotID, timeout)
+	if err != nil {
+		return err
+	}
+	if snapshot.State != instance.SnapshotStateAvailable {
+		return fmt.Errorf("export of snapshot %s to %s/%s failed, snapshot is %s", snapshotID, bucket, key, snapshot.State)
+	}
+
+	return nil
+}
+
+// waitForInstanceSnapshotObject waits for the object of a snapshot export to be written.
+// The snapshot may not be exporting yet when the export call returns, the export is only over once the object exists.
+func waitForInstanceSnapshotObject(ctx context.Context, api *instance.API, s3Client *s3.S3, zone scw.Zone, snapshotID string, bucket string, key string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err == nil {
+			return nil
+		}
+		if !isS3Err(err, "NotFound", "") && !isS3Err(err, s3.ErrCodeNoSuchKey, "") {
+			return resource.NonRetryableError(err)
+		}
+
+		res, err := api.GetSnapshot(&instance.GetSnapshotRequest{
+			Zone:       zone,
+			SnapshotID: snapshotID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if res.Snapshot.State == instance.SnapshotStateError {
+			return resource.NonRetryableError(fmt.Errorf("export of snapshot %s to %s/%s failed, snapshot is %s", snapshotID, bucket, key, res.Snapshot.State))
+		}
+
+		return resource.RetryableError(fmt.Errorf("snapshot %s is not exported to %s/%s yet", snapshotID, bucket, key))
+	})
+}
+
 func waitForInstanceVolume(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Volume, error) {
 	retryInterval := defaultInstanceRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -584,3 +655,62 @@ func flattenInstanceImageExtraVolumes(volumes map[string]*instance.Volume, zone
 	}
 	return volumesFlat
 }
+
+// getInstanceServerCloudInitStatus returns the cloud-init status reported in the server user data, empty if not reported yet.
+func getInstanceServerCloudInitStatus(ctx context.Context, api *instance.API, zone scw.Zone, serverID string) (string, error) {
+	res, err := api.GetServerUserData(&instance.GetServerUserDataRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		Key:      instanceServerCloudInitStatusKey,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	status, err := io.ReadAll(res)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(status)), nil
+}
+
+// parseInstanceServerCloudInitStatus returns whether cloud-init is over and an error if it reported a failure.
+// A status is either "done" or "error" optionally followed by a message, e.g. "error: apt-get install failed".
+func parseInstanceServerCloudInitStatus(status string) (bool, error) {
+	switch {
+	case status == "done":
+		return true, nil
+	case strings.HasPrefix(status, "error"):
+		message := strings.TrimSpace(strings.TrimLeft(strings.TrimPrefix(status, "error"), ":"))
+		if message == "" {
+			message = "no details reported"
+		}
+		return true, fmt.Errorf("cloud-init failed: %s", message)
+	default:
+		return false, nil
+	}
+}
+
+// waitForInstanceServerCloudInit polls the cloud-init status of a server until cloud-init reports it is over.
+func waitForInstanceServerCloudInit(ctx context.Context, api *instance.API, zone scw.Zone, serverID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		status, err := getInstanceServerCloudInitStatus(ctx, api, zone, serverID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		done, err := parseInstanceServerCloudInitStatus(status)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if !done {
+			return resource.RetryableError(fmt.Errorf("cloud-init of server %s is not done yet", serverID))
+		}
+
+		return nil
+	})
+}
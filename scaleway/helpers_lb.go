@@ -2,6 +2,9 @@ package scaleway
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -10,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	validator "github.com/scaleway/scaleway-sdk-go/validation"
@@ -51,6 +55,117 @@ func flattenLbBackendMarkdownAction(action lbSDK.OnMarkedDownAction) interface{}
 	return action.String()
 }
 
+// lbACLMatchSchema returns the schema of an ACL match rule
+func lbACLMatchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		MinItems:    1,
+		Description: "The ACL match rule",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_subnet": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "A list of IPs or CIDR v4/v6 addresses of the client of the session to match",
+				},
+				"http_filter": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  lbSDK.ACLHTTPFilterACLHTTPFilterNone.String(),
+					ValidateFunc: validation.StringInSlice([]string{
+						lbSDK.ACLHTTPFilterACLHTTPFilterNone.String(),
+						lbSDK.ACLHTTPFilterPathBegin.String(),
+						lbSDK.ACLHTTPFilterPathEnd.String(),
+						lbSDK.ACLHTTPFilterRegex.String(),
+						lbSDK.ACLHTTPFilterHTTPHeaderMatch.String(),
+					}, false),
+					Description: "The HTTP filter to match",
+				},
+				"http_filter_value": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "A list of possible values to match for the given HTTP filter",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"http_filter_option": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "You can use this field with http_header_match acl type to set the header name to filter",
+				},
+				"invert": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: `If set to true, the condition will be of type "unless"`,
+				},
+			},
+		},
+	}
+}
+
+// lbACLActionSchema returns the schema of an ACL action, including redirections
+func lbACLActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		Description: "Action to undertake when an ACL filter matches",
+		MaxItems:    1,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						lbSDK.ACLActionTypeAllow.String(),
+						lbSDK.ACLActionTypeDeny.String(),
+						lbSDK.ACLActionTypeRedirect.String(),
+					}, false),
+					Description: "The action type",
+				},
+				"redirect": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Redirect parameters when using an ACL with `redirect` action",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  lbSDK.ACLActionRedirectRedirectTypeLocation.String(),
+								ValidateFunc: validation.StringInSlice([]string{
+									lbSDK.ACLActionRedirectRedirectTypeLocation.String(),
+									lbSDK.ACLActionRedirectRedirectTypeScheme.String(),
+								}, false),
+								Description: "The redirect type",
+							},
+							"target": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "An URL can be used in case of a location redirect or a scheme name to replace the request's original scheme",
+							},
+							"code": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      302,
+								ValidateFunc: validation.IntInSlice([]int{301, 302, 303, 307, 308}),
+								Description:  "The HTTP redirect code to use",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func flattenLbACL(acl *lbSDK.ACL) interface{} {
 	res := map[string]interface{}{
 		"name":   acl.Name,
@@ -79,9 +194,21 @@ func expandLbACL(i interface{}) *lbSDK.ACL {
 }
 
 func flattenLbACLAction(action *lbSDK.ACLAction) interface{} {
+	rawAction := map[string]interface{}{
+		"type": action.Type,
+	}
+	if action.Redirect != nil {
+		rawAction["redirect"] = flattenLbACLActionRedirect(action.Redirect)
+	}
+	return []map[string]interface{}{rawAction}
+}
+
+func flattenLbACLActionRedirect(redirect *lbSDK.ACLActionRedirect) interface{} {
 	return []map[string]interface{}{
 		{
-			"type": action.Type,
+			"type":   redirect.Type,
+			"target": redirect.Target,
+			"code":   flattenInt32Ptr(redirect.Code),
 		},
 	}
 }
@@ -205,9 +332,25 @@ func expandLbACLAction(raw interface{}) *lbSDK.ACLAction {
 		return nil
 	}
 	rawMap := raw.([]interface{})[0].(map[string]interface{})
-	return &lbSDK.ACLAction{
+	action := &lbSDK.ACLAction{
 		Type: lbSDK.ACLActionType(rawMap["type"].(string)),
 	}
+	if rawRedirect, exist := rawMap["redirect"]; exist {
+		action.Redirect = expandLbACLActionRedirect(rawRedirect)
+	}
+	return action
+}
+
+func expandLbACLActionRedirect(raw interface{}) *lbSDK.ACLActionRedirect {
+	if raw == nil || len(raw.([]interface{})) != 1 {
+		return nil
+	}
+	rawMap := raw.([]interface{})[0].(map[string]interface{})
+	return &lbSDK.ACLActionRedirect{
+		Type:   lbSDK.ACLActionRedirectRedirectType(rawMap["type"].(string)),
+		Target: rawMap["target"].(string),
+		Code:   expandInt32Ptr(rawMap["code"]),
+	}
 }
 
 func flattenLbACLMatch(match *lbSDK.ACLMatch) interface{} {
@@ -369,6 +512,32 @@ func expandLbCustomCertificate(raw interface{}) *lbSDK.CreateCertificateRequestC
 	return config
 }
 
+// parseLbCertificateChain returns the first certificate of a PEM chain, the chain may also contain the private key.
+func parseLbCertificateChain(chain string) (*x509.Certificate, error) {
+	rest := []byte(chain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("no certificate found in PEM certificate chain")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// flattenLbCertificateSubjectAlternativeNames returns the alternative names of a certificate as returned by the API, without its common name.
+func flattenLbCertificateSubjectAlternativeNames(certificate *x509.Certificate) []string {
+	names := []string(nil)
+	for _, name := range certificate.DNSNames {
+		if name != certificate.Subject.CommonName {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func expandLbProxyProtocol(raw interface{}) lbSDK.ProxyProtocol {
 	return lbSDK.ProxyProtocol("proxy_protocol_" + raw.(string))
 }
@@ -534,3 +703,40 @@ func attachLBPrivateNetwork(ctx context.Context, lbAPI *lbSDK.ZonedAPI, zone scw
 
 	return privateNetworks, nil
 }
+
+func flattenLbBackendServerStats(stats []*lbSDK.BackendServerStats, zone scw.Zone) interface{} {
+	rawStats := make([]interface{}, 0, len(stats))
+	for _, stat := range stats {
+		rawStats = append(rawStats, map[string]interface{}{
+			"instance_id":              stat.InstanceID,
+			"backend_id":               newZonedIDString(zone, stat.BackendID),
+			"ip":                       stat.IP,
+			"server_state":             stat.ServerState.String(),
+			"server_state_changed_at":  flattenTime(stat.ServerStateChangedAt),
+			"last_health_check_status": stat.LastHealthCheckStatus.String(),
+		})
+	}
+	return rawStats
+}
+
+// lbACLRequiresHTTP returns true if the acl can only be evaluated on an HTTP backend
+func lbACLRequiresHTTP(acl *lbSDK.ACL) bool {
+	if acl.Match != nil && acl.Match.HTTPFilter != "" && acl.Match.HTTPFilter != lbSDK.ACLHTTPFilterACLHTTPFilterNone {
+		return true
+	}
+	return acl.Action != nil && acl.Action.Type == lbSDK.ACLActionTypeRedirect
+}
+
+// validateLbACLAction checks that a redirect block is set if and only if the action is a redirect
+func validateLbACLAction(action *lbSDK.ACLAction) error {
+	if action == nil {
+		return nil
+	}
+	if action.Type == lbSDK.ACLActionTypeRedirect && action.Redirect == nil {
+		return fmt.Errorf("acl action %s requires a redirect block", action.Type)
+	}
+	if action.Type != lbSDK.ACLActionTypeRedirect && action.Redirect != nil {
+		return fmt.Errorf("acl action %s does not accept a redirect block", action.Type)
+	}
+	return nil
+}
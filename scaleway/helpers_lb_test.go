@@ -1,9 +1,18 @@
 package scaleway
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,3 +60,117 @@ func TestIsEqualPrivateNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandLbACLActionRedirect(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"type": "redirect",
+			"redirect": []interface{}{
+				map[string]interface{}{
+					"type":   "scheme",
+					"target": "https",
+					"code":   301,
+				},
+			},
+		},
+	}
+
+	action := expandLbACLAction(raw)
+	assert.Equal(t, lbSDK.ACLActionTypeRedirect, action.Type)
+	assert.Equal(t, lbSDK.ACLActionRedirectRedirectTypeScheme, action.Redirect.Type)
+	assert.Equal(t, "https", action.Redirect.Target)
+	assert.Equal(t, int32(301), *action.Redirect.Code)
+
+	flattened := flattenLbACLAction(action).([]map[string]interface{})
+	assert.Equal(t, lbSDK.ACLActionTypeRedirect, flattened[0]["type"])
+	redirect := flattened[0]["redirect"].([]map[string]interface{})
+	assert.Equal(t, lbSDK.ACLActionRedirectRedirectTypeScheme, redirect[0]["type"])
+	assert.Equal(t, "https", redirect[0]["target"])
+	assert.Equal(t, int32(301), redirect[0]["code"])
+}
+
+func TestFlattenLbACLActionWithoutRedirect(t *testing.T) {
+	flattened := flattenLbACLAction(&lbSDK.ACLAction{Type: lbSDK.ACLActionTypeAllow}).([]map[string]interface{})
+	_, hasRedirect := flattened[0]["redirect"]
+	assert.False(t, hasRedirect)
+}
+
+func TestFlattenLbBackendServerStats(t *testing.T) {
+	changedAt := time.Date(2022, 12, 1, 10, 0, 0, 0, time.UTC)
+	stats := []*lbSDK.BackendServerStats{
+		{
+			InstanceID:            "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			BackendID:             "6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+			IP:                    "10.0.0.1",
+			ServerState:           lbSDK.BackendServerStatsServerStateRunning,
+			ServerStateChangedAt:  &changedAt,
+			LastHealthCheckStatus: lbSDK.BackendServerStatsHealthCheckStatusPassed,
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"instance_id":              "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			"backend_id":               "fr-par-1/6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+			"ip":                       "10.0.0.1",
+			"server_state":             "running",
+			"server_state_changed_at":  "2022-12-01T10:00:00Z",
+			"last_health_check_status": "passed",
+		},
+	}
+	assert.Equal(t, expected, flattenLbBackendServerStats(stats, scw.ZoneFrPar1))
+}
+
+func TestParseLbCertificateChain(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "scaleway.com"},
+		DNSNames:     []string{"scaleway.com", "www.scaleway.com"},
+		NotBefore:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	chain := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	certificate, err := parseLbCertificateChain(chain)
+	assert.NoError(t, err)
+	assert.Equal(t, "scaleway.com", certificate.Subject.CommonName)
+	assert.Equal(t, notAfter, certificate.NotAfter.UTC())
+	assert.Equal(t, []string{"www.scaleway.com"}, flattenLbCertificateSubjectAlternativeNames(certificate))
+
+	_, err = parseLbCertificateChain(string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})))
+	assert.Error(t, err)
+}
+
+func TestLbACLRequiresHTTP(t *testing.T) {
+	assert.False(t, lbACLRequiresHTTP(&lbSDK.ACL{
+		Match:  &lbSDK.ACLMatch{HTTPFilter: lbSDK.ACLHTTPFilterACLHTTPFilterNone},
+		Action: &lbSDK.ACLAction{Type: lbSDK.ACLActionTypeDeny},
+	}))
+	assert.True(t, lbACLRequiresHTTP(&lbSDK.ACL{
+		Match:  &lbSDK.ACLMatch{HTTPFilter: lbSDK.ACLHTTPFilterPathBegin},
+		Action: &lbSDK.ACLAction{Type: lbSDK.ACLActionTypeAllow},
+	}))
+	assert.True(t, lbACLRequiresHTTP(&lbSDK.ACL{
+		Match:  &lbSDK.ACLMatch{HTTPFilter: lbSDK.ACLHTTPFilterACLHTTPFilterNone},
+		Action: &lbSDK.ACLAction{Type: lbSDK.ACLActionTypeRedirect},
+	}))
+}
+
+func TestValidateLbACLAction(t *testing.T) {
+	redirect := &lbSDK.ACLActionRedirect{Type: lbSDK.ACLActionRedirectRedirectTypeLocation, Target: "https://example.com"}
+
+	assert.NoError(t, validateLbACLAction(&lbSDK.ACLAction{Type: lbSDK.ACLActionTypeAllow}))
+	assert.NoError(t, validateLbACLAction(&lbSDK.ACLAction{Type: lbSDK.ACLActionTypeRedirect, Redirect: redirect}))
+	assert.Error(t, validateLbACLAction(&lbSDK.ACLAction{Type: lbSDK.ACLActionTypeRedirect}))
+	assert.Error(t, validateLbACLAction(&lbSDK.ACLAction{Type: lbSDK.ACLActionTypeDeny, Redirect: redirect}))
+}
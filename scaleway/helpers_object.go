@@ -48,6 +48,10 @@ func newS3Client(httpClient *http.Client, region, accessKey, secretKey string) (
 
 func newS3ClientFromMeta(meta *Meta) (*s3.S3, error) {
 	region, _ := meta.scwClient.GetDefaultRegion()
+	return newS3ClientFromMetaWithRegion(meta, region)
+}
+
+func newS3ClientFromMetaWithRegion(meta *Meta, region scw.Region) (*s3.S3, error) {
 	accessKey, _ := meta.scwClient.GetAccessKey()
 	secretKey, _ := meta.scwClient.GetSecretKey()
 	return newS3Client(meta.httpClient, region.String(), accessKey, secretKey)
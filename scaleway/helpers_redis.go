@@ -55,6 +55,20 @@ func waitForRedisCluster(ctx context.Context, api *redis.API, zone scw.Zone, id
 	}, scw.WithContext(ctx))
 }
 
+// waitForRedisClusterMigration waits for a migration to end and returns an error if the cluster is not ready afterwards.
+func waitForRedisClusterMigration(ctx context.Context, api *redis.API, zone scw.Zone, id string, timeout time.Duration) error {
+	cluster, err := waitForRedisCluster(ctx, api, zone, id, timeout)
+	if err != nil {
+		return err
+	}
+
+	if cluster.Status != redis.ClusterStatusReady {
+		return fmt.Errorf("migration of redis cluster %s ended with status %s", id, cluster.Status)
+	}
+
+	return nil
+}
+
 func expandRedisPrivateNetwork(data []interface{}) ([]*redis.EndpointSpec, error) {
 	if data == nil {
 		return nil, nil
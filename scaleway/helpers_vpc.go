@@ -2,7 +2,10 @@ package scaleway
 
 import (
 	"fmt"
+	"net"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -40,3 +43,72 @@ func vpcAPI(m interface{}) (*vpc.API, error) {
 
 	return vpc.NewAPI(meta.scwClient), nil
 }
+
+// vpcSubnetsContainAddress returns true if the address, in CIDR format, belongs to one of the subnets.
+// An empty subnet list is considered to contain any address as the private network is not managed by IPAM.
+func vpcSubnetsContainAddress(subnets []scw.IPNet, address string) (bool, error) {
+	if len(subnets) == 0 {
+		return true, nil
+	}
+
+	ip, _, err := net.ParseCIDR(address)
+	if err != nil {
+		return false, err
+	}
+
+	for _, subnet := range subnets {
+		if subnet.Contains(ip) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// expandVPCPrivateNetworkSubnets merges the ipv4_subnet and ipv6_subnets blocks of a private network.
+func expandVPCPrivateNetworkSubnets(rawIPv4Subnet interface{}, rawIPv6Subnets interface{}) ([]scw.IPNet, error) {
+	var subnets []scw.IPNet
+	for _, raw := range append(rawIPv4Subnet.([]interface{}), rawIPv6Subnets.([]interface{})...) {
+		if raw == nil {
+			continue
+		}
+		subnet, err := expandIPNet(raw.(map[string]interface{})["subnet"].(string))
+		if err != nil {
+			return nil, err
+		}
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
+
+// flattenVPCPrivateNetworkSubnets splits the subnets of a private network in ipv4_subnet and ipv6_subnets blocks.
+// Only the first IPv4 subnet fits in ipv4_subnet, a warning is returned if the private network has other ones.
+func flattenVPCPrivateNetworkSubnets(subnets []scw.IPNet) ([]map[string]interface{}, []map[string]interface{}, diag.Diagnostics) {
+	var ipv4Subnet, ipv6Subnets []map[string]interface{}
+	var diags diag.Diagnostics
+	for _, subnet := range subnets {
+		raw, err := flattenIPNet(subnet)
+		if err != nil {
+			return nil, nil, diag.FromErr(err)
+		}
+		block := map[string]interface{}{
+			"subnet": raw,
+		}
+		switch {
+		case subnet.IP.To4() == nil:
+			ipv6Subnets = append(ipv6Subnets, block)
+		case len(ipv4Subnet) == 0:
+			ipv4Subnet = append(ipv4Subnet, block)
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Private network IPv4 subnet ignored",
+				Detail:        fmt.Sprintf("the private network has more than one IPv4 subnet, only %s is kept in ipv4_subnet and %s is ignored", ipv4Subnet[0]["subnet"], raw),
+				AttributePath: cty.GetAttrPath("ipv4_subnet"),
+			})
+		}
+	}
+
+	return ipv4Subnet, ipv6Subnets, diags
+}
@@ -2,8 +2,11 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -90,3 +93,156 @@ func waitForDHCPEntries(ctx context.Context, api *vpcgw.API, zone scw.Zone, gate
 	dhcpEntries, err := api.WaitForDHCPEntries(req, scw.WithContext(ctx))
 	return dhcpEntries, err
 }
+
+func flattenVPCPublicGatewayDHCPEntries(entries []*vpcgw.DHCPEntry, zone scw.Zone) []interface{} {
+	rawEntries := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		rawEntries = append(rawEntries, map[string]interface{}{
+			"id":          newZonedIDString(zone, entry.ID),
+			"mac_address": entry.MacAddress,
+			"ip_address":  entry.IPAddress.String(),
+			"hostname":    entry.Hostname,
+			"type":        entry.Type.String(),
+			"created_at":  flattenTime(entry.CreatedAt),
+			"updated_at":  flattenTime(entry.UpdatedAt),
+		})
+	}
+	return rawEntries
+}
+
+// flattenVPCPublicGatewayDHCPReservations returns the mac and ip addresses of the reservations, leases are ignored.
+func flattenVPCPublicGatewayDHCPReservations(entries []*vpcgw.DHCPEntry) []interface{} {
+	rawEntries := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type != vpcgw.DHCPEntryTypeReservation {
+			continue
+		}
+		rawEntries = append(rawEntries, map[string]interface{}{
+			"mac_address": normalizeMACAddress(entry.MacAddress),
+			"ip_address":  entry.IPAddress.String(),
+		})
+	}
+	return rawEntries
+}
+
+// normalizeMACAddress returns the mac address in its lowercase colon separated form, invalid addresses are returned as is.
+func normalizeMACAddress(i interface{}) string {
+	macAddress, err := net.ParseMAC(i.(string))
+	if err != nil {
+		return i.(string)
+	}
+	return macAddress.String()
+}
+
+// vpcPublicGatewayDHCPReservationHash identifies a reservation by its normalized mac address and its ip address.
+func vpcPublicGatewayDHCPReservationHash(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	return StringHashcode(fmt.Sprintf("%s-%s", normalizeMACAddress(m["mac_address"]), m["ip_address"].(string)))
+}
+
+func expandVPCPublicGatewayDHCPReservations(raw interface{}) ([]*vpcgw.SetDHCPEntriesRequestEntry, error) {
+	entries := []*vpcgw.SetDHCPEntriesRequestEntry{}
+	for _, rawEntry := range raw.(*schema.Set).List() {
+		entry := rawEntry.(map[string]interface{})
+
+		ip := net.ParseIP(entry["ip_address"].(string))
+		if ip == nil {
+			return nil, fmt.Errorf("could not parse ip_address %s", entry["ip_address"])
+		}
+		macAddress, err := net.ParseMAC(entry["mac_address"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &vpcgw.SetDHCPEntriesRequestEntry{
+			MacAddress: macAddress.String(),
+			IPAddress:  ip,
+		})
+	}
+	return entries, nil
+}
+
+func expandVPCPublicGatewayPATRules(raw interface{}) []*vpcgw.SetPATRulesRequestRule {
+	rules := []*vpcgw.SetPATRulesRequestRule{}
+	for _, rawRule := range raw.(*schema.Set).List() {
+		rule := rawRule.(map[string]interface{})
+		rules = append(rules, &vpcgw.SetPATRulesRequestRule{
+			PublicPort:  uint32(rule["public_port"].(int)),
+			PrivateIP:   net.ParseIP(rule["private_ip"].(string)),
+			PrivatePort: uint32(rule["private_port"].(int)),
+			Protocol:    vpcgw.PATRuleProtocol(rule["protocol"].(string)),
+		})
+	}
+	return rules
+}
+
+// expandVPCPublicGatewayPATRulesRawConfig returns the PAT rules of the raw configuration whose public port is known.
+// Unlike the state set, the raw configuration keeps rules which only differ by their private ip or port.
+func expandVPCPublicGatewayPATRulesRawConfig(raw cty.Value) []*vpcgw.SetPATRulesRequestRule {
+	rules := []*vpcgw.SetPATRulesRequestRule{}
+	if raw.IsNull() || !raw.IsKnown() {
+		return rules
+	}
+
+	for it := raw.ElementIterator(); it.Next(); {
+		_, rawRule := it.Element()
+		if rawRule.IsNull() || !rawRule.IsKnown() {
+			continue
+		}
+		publicPort := rawRule.GetAttr("public_port")
+		protocol := rawRule.GetAttr("protocol")
+		if publicPort.IsNull() || !publicPort.IsKnown() || !protocol.IsKnown() {
+			continue
+		}
+
+		port, _ := publicPort.AsBigFloat().Uint64()
+		rule := &vpcgw.SetPATRulesRequestRule{
+			PublicPort: uint32(port),
+			Protocol:   vpcgw.PATRuleProtocolBoth,
+		}
+		if !protocol.IsNull() {
+			rule.Protocol = vpcgw.PATRuleProtocol(protocol.AsString())
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenVPCPublicGatewayPATRules(rules []*vpcgw.PATRule) []interface{} {
+	rawRules := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		rawRules = append(rawRules, map[string]interface{}{
+			"public_port":  int(rule.PublicPort),
+			"private_ip":   rule.PrivateIP.String(),
+			"private_port": int(rule.PrivatePort),
+			"protocol":     rule.Protocol.String(),
+		})
+	}
+	return rawRules
+}
+
+// validateVPCPublicGatewayPATRules checks that a public port is not forwarded twice for the same protocol,
+// a rule using both protocols overlaps with tcp and udp rules on the same public port.
+func validateVPCPublicGatewayPATRules(rules []*vpcgw.SetPATRulesRequestRule) error {
+	protocols := map[uint32]map[vpcgw.PATRuleProtocol]bool{}
+	for _, rule := range rules {
+		used := protocols[rule.PublicPort]
+		if used == nil {
+			used = map[vpcgw.PATRuleProtocol]bool{}
+			protocols[rule.PublicPort] = used
+		}
+
+		overlap := used[rule.Protocol] || used[vpcgw.PATRuleProtocolBoth]
+		if rule.Protocol == vpcgw.PATRuleProtocolBoth {
+			overlap = overlap || used[vpcgw.PATRuleProtocolTCP] || used[vpcgw.PATRuleProtocolUDP]
+		}
+		if overlap {
+			return fmt.Errorf("public port %d is forwarded more than once for protocol %s", rule.PublicPort, rule.Protocol)
+		}
+		used[rule.Protocol] = true
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package scaleway
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenVPCPublicGatewayDHCPEntries(t *testing.T) {
+	entries := []*vpcgw.DHCPEntry{
+		{
+			ID:         "11111111-1111-1111-1111-111111111111",
+			MacAddress: "02:00:00:00:00:01",
+			IPAddress:  net.ParseIP("192.168.1.10"),
+			Hostname:   "reserved",
+			Type:       vpcgw.DHCPEntryTypeReservation,
+		},
+		{
+			ID:         "22222222-2222-2222-2222-222222222222",
+			MacAddress: "02:00:00:00:00:02",
+			IPAddress:  net.ParseIP("192.168.1.20"),
+			Hostname:   "leased",
+			Type:       vpcgw.DHCPEntryTypeLease,
+		},
+	}
+
+	rawEntries := flattenVPCPublicGatewayDHCPEntries(entries, scw.ZoneFrPar1)
+	assert.Len(t, rawEntries, 2)
+	assert.Equal(t, "fr-par-1/22222222-2222-2222-2222-222222222222", rawEntries[1].(map[string]interface{})["id"])
+	assert.Equal(t, "lease", rawEntries[1].(map[string]interface{})["type"])
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"mac_address": "02:00:00:00:00:01",
+			"ip_address":  "192.168.1.10",
+		},
+	}, flattenVPCPublicGatewayDHCPReservations(entries))
+}
+
+func TestExpandVPCPublicGatewayDHCPReservations(t *testing.T) {
+	entrySchema := resourceScalewayVPCPublicGatewayDHCPReservations().Schema["entry"]
+	set := schema.NewSet(entrySchema.Set, []interface{}{
+		map[string]interface{}{
+			"mac_address": "02:00:00:00:00:01",
+			"ip_address":  "192.168.1.10",
+		},
+	})
+
+	entries, err := expandVPCPublicGatewayDHCPReservations(set)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "02:00:00:00:00:01", entries[0].MacAddress)
+	assert.Equal(t, "192.168.1.10", entries[0].IPAddress.String())
+
+	// mac addresses are compared in their normalized form
+	assert.Equal(t, "02:00:00:00:00:0a", normalizeMACAddress("02-00-00-00-00-0A"))
+	assert.Equal(t, "invalid", normalizeMACAddress("invalid"))
+	assert.Equal(t,
+		entrySchema.Set(map[string]interface{}{"mac_address": "02:00:00:00:00:0a", "ip_address": "192.168.1.10"}),
+		entrySchema.Set(map[string]interface{}{"mac_address": "02:00:00:00:00:0A", "ip_address": "192.168.1.10"}),
+	)
+}
+
+func TestVPCPublicGatewayPATRules(t *testing.T) {
+	ruleHash := schema.HashResource(resourceScalewayVPCPublicGatewayPATRules().Schema["rule"].Elem.(*schema.Resource))
+	set := schema.NewSet(ruleHash, []interface{}{
+		map[string]interface{}{"public_port": 2201, "private_ip": "192.168.1.1", "private_port": 22, "protocol": "tcp"},
+		map[string]interface{}{"public_port": 2202, "private_ip": "192.168.1.2", "private_port": 22, "protocol": "tcp"},
+	})
+
+	// rules only differing by their private ip or port are distinct
+	assert.NotEqual(t,
+		ruleHash(map[string]interface{}{"public_port": 2201, "private_ip": "192.168.1.1", "private_port": 22, "protocol": "tcp"}),
+		ruleHash(map[string]interface{}{"public_port": 2201, "private_ip": "192.168.1.42", "private_port": 2222, "protocol": "tcp"}),
+	)
+
+	rules := expandVPCPublicGatewayPATRules(set)
+	assert.Len(t, rules, 2)
+	assert.NoError(t, validateVPCPublicGatewayPATRules(rules))
+
+	assert.ElementsMatch(t, set.List(), flattenVPCPublicGatewayPATRules([]*vpcgw.PATRule{
+		{PublicPort: 2201, PrivateIP: net.ParseIP("192.168.1.1"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+		{PublicPort: 2202, PrivateIP: net.ParseIP("192.168.1.2"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+	}))
+
+	assert.NoError(t, validateVPCPublicGatewayPATRules([]*vpcgw.SetPATRulesRequestRule{
+		{PublicPort: 53, Protocol: vpcgw.PATRuleProtocolTCP},
+		{PublicPort: 53, Protocol: vpcgw.PATRuleProtocolUDP},
+	}))
+	assert.Error(t, validateVPCPublicGatewayPATRules([]*vpcgw.SetPATRulesRequestRule{
+		{PublicPort: 53, Protocol: vpcgw.PATRuleProtocolTCP},
+		{PublicPort: 53, Protocol: vpcgw.PATRuleProtocolBoth},
+	}))
+}
+
+func TestExpandVPCPublicGatewayPATRulesRawConfig(t *testing.T) {
+	rawRule := func(publicPort int64, privateIP string, protocol cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"public_port":  cty.NumberIntVal(publicPort),
+			"private_ip":   cty.StringVal(privateIP),
+			"private_port": cty.NumberIntVal(22),
+			"protocol":     protocol,
+		})
+	}
+
+	rules := expandVPCPublicGatewayPATRulesRawConfig(cty.SetVal([]cty.Value{
+		rawRule(2201, "192.168.1.1", cty.StringVal("tcp")),
+		rawRule(2201, "192.168.1.2", cty.StringVal("tcp")),
+		rawRule(2202, "192.168.1.3", cty.NullVal(cty.String)),
+	}))
+	assert.Len(t, rules, 3)
+	assert.ElementsMatch(t, []vpcgw.PATRuleProtocol{vpcgw.PATRuleProtocolTCP, vpcgw.PATRuleProtocolTCP, vpcgw.PATRuleProtocolBoth}, []vpcgw.PATRuleProtocol{rules[0].Protocol, rules[1].Protocol, rules[2].Protocol})
+	assert.Error(t, validateVPCPublicGatewayPATRules(rules))
+
+	assert.Empty(t, expandVPCPublicGatewayPATRulesRawConfig(cty.NullVal(cty.Set(cty.DynamicPseudoType))))
+	assert.Len(t, expandVPCPublicGatewayPATRulesRawConfig(cty.SetVal([]cty.Value{
+		rawRule(2201, "192.168.1.1", cty.UnknownVal(cty.String)),
+		rawRule(2202, "192.168.1.1", cty.StringVal("udp")),
+	})), 1)
+}
@@ -84,81 +84,91 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
-				"scaleway_account_project":                     resourceScalewayAccountProject(),
-				"scaleway_account_ssh_key":                     resourceScalewayAccountSSKKey(),
-				"scaleway_apple_silicon_server":                resourceScalewayAppleSiliconServer(),
-				"scaleway_baremetal_server":                    resourceScalewayBaremetalServer(),
-				"scaleway_container_namespace":                 resourceScalewayContainerNamespace(),
-				"scaleway_container_cron":                      resourceScalewayContainerCron(),
-				"scaleway_container_domain":                    resourceScalewayContainerDomain(),
-				"scaleway_domain_record":                       resourceScalewayDomainRecord(),
-				"scaleway_domain_zone":                         resourceScalewayDomainZone(),
-				"scaleway_flexible_ip":                         resourceScalewayFlexibleIP(),
-				"scaleway_function":                            resourceScalewayFunction(),
-				"scaleway_function_cron":                       resourceScalewayFunctionCron(),
-				"scaleway_function_domain":                     resourceScalewayFunctionDomain(),
-				"scaleway_function_namespace":                  resourceScalewayFunctionNamespace(),
-				"scaleway_function_token":                      resourceScalewayFunctionToken(),
-				"scaleway_iam_api_key":                         resourceScalewayIamAPIKey(),
-				"scaleway_iam_application":                     resourceScalewayIamApplication(),
-				"scaleway_iam_group":                           resourceScalewayIamGroup(),
-				"scaleway_iam_policy":                          resourceScalewayIamPolicy(),
-				"scaleway_instance_user_data":                  resourceScalewayInstanceUserData(),
-				"scaleway_instance_image":                      resourceScalewayInstanceImage(),
-				"scaleway_instance_ip":                         resourceScalewayInstanceIP(),
-				"scaleway_instance_ip_reverse_dns":             resourceScalewayInstanceIPReverseDNS(),
-				"scaleway_instance_volume":                     resourceScalewayInstanceVolume(),
-				"scaleway_instance_security_group":             resourceScalewayInstanceSecurityGroup(),
-				"scaleway_instance_security_group_rules":       resourceScalewayInstanceSecurityGroupRules(),
-				"scaleway_instance_server":                     resourceScalewayInstanceServer(),
-				"scaleway_instance_snapshot":                   resourceScalewayInstanceSnapshot(),
-				"scaleway_iam_ssh_key":                         resourceScalewayIamSSKKey(),
-				"scaleway_instance_placement_group":            resourceScalewayInstancePlacementGroup(),
-				"scaleway_instance_private_nic":                resourceScalewayInstancePrivateNIC(),
-				"scaleway_iot_hub":                             resourceScalewayIotHub(),
-				"scaleway_iot_device":                          resourceScalewayIotDevice(),
-				"scaleway_iot_route":                           resourceScalewayIotRoute(),
-				"scaleway_iot_network":                         resourceScalewayIotNetwork(),
-				"scaleway_k8s_cluster":                         resourceScalewayK8SCluster(),
-				"scaleway_k8s_pool":                            resourceScalewayK8SPool(),
-				"scaleway_lb":                                  resourceScalewayLb(),
-				"scaleway_lb_ip":                               resourceScalewayLbIP(),
-				"scaleway_lb_backend":                          resourceScalewayLbBackend(),
-				"scaleway_lb_certificate":                      resourceScalewayLbCertificate(),
-				"scaleway_lb_frontend":                         resourceScalewayLbFrontend(),
-				"scaleway_lb_route":                            resourceScalewayLbRoute(),
-				"scaleway_registry_namespace":                  resourceScalewayRegistryNamespace(),
-				"scaleway_tem_domain":                          resourceScalewayTemDomain(),
-				"scaleway_container":                           resourceScalewayContainer(),
-				"scaleway_container_token":                     resourceScalewayContainerToken(),
-				"scaleway_rdb_acl":                             resourceScalewayRdbACL(),
-				"scaleway_rdb_database":                        resourceScalewayRdbDatabase(),
-				"scaleway_rdb_database_backup":                 resourceScalewayRdbDatabaseBackup(),
-				"scaleway_rdb_instance":                        resourceScalewayRdbInstance(),
-				"scaleway_rdb_privilege":                       resourceScalewayRdbPrivilege(),
-				"scaleway_rdb_user":                            resourceScalewayRdbUser(),
-				"scaleway_rdb_read_replica":                    resourceScalewayRdbReadReplica(),
-				"scaleway_redis_cluster":                       resourceScalewayRedisCluster(),
-				"scaleway_object":                              resourceScalewayObject(),
-				"scaleway_object_bucket":                       resourceScalewayObjectBucket(),
-				"scaleway_object_bucket_acl":                   resourceScalewayObjectBucketACL(),
-				"scaleway_object_bucket_lock_configuration":    resourceObjectLockConfiguration(),
-				"scaleway_object_bucket_policy":                resourceScalewayObjectBucketPolicy(),
-				"scaleway_object_bucket_website_configuration": ResourceBucketWebsiteConfiguration(),
-				"scaleway_mnq_namespace":                       resourceScalewayMNQNamespace(),
-				"scaleway_vpc_public_gateway":                  resourceScalewayVPCPublicGateway(),
-				"scaleway_vpc_gateway_network":                 resourceScalewayVPCGatewayNetwork(),
-				"scaleway_vpc_public_gateway_dhcp":             resourceScalewayVPCPublicGatewayDHCP(),
-				"scaleway_vpc_public_gateway_dhcp_reservation": resourceScalewayVPCPublicGatewayDHCPReservation(),
-				"scaleway_vpc_public_gateway_ip":               resourceScalewayVPCPublicGatewayIP(),
-				"scaleway_vpc_public_gateway_pat_rule":         resourceScalewayVPCPublicGatewayPATRule(),
-				"scaleway_vpc_private_network":                 resourceScalewayVPCPrivateNetwork(),
+				"scaleway_account_project":                      resourceScalewayAccountProject(),
+				"scaleway_account_ssh_key":                      resourceScalewayAccountSSKKey(),
+				"scaleway_apple_silicon_server":                 resourceScalewayAppleSiliconServer(),
+				"scaleway_baremetal_bmc_access":                 resourceScalewayBaremetalBMCAccess(),
+				"scaleway_baremetal_server":                     resourceScalewayBaremetalServer(),
+				"scaleway_container_namespace":                  resourceScalewayContainerNamespace(),
+				"scaleway_container_cron":                       resourceScalewayContainerCron(),
+				"scaleway_container_domain":                     resourceScalewayContainerDomain(),
+				"scaleway_domain_record":                        resourceScalewayDomainRecord(),
+				"scaleway_domain_zone":                          resourceScalewayDomainZone(),
+				"scaleway_flexible_ip":                          resourceScalewayFlexibleIP(),
+				"scaleway_flexible_ip_mac_address":              resourceScalewayFlexibleIPMACAddress(),
+				"scaleway_function":                             resourceScalewayFunction(),
+				"scaleway_function_cron":                        resourceScalewayFunctionCron(),
+				"scaleway_function_domain":                      resourceScalewayFunctionDomain(),
+				"scaleway_function_namespace":                   resourceScalewayFunctionNamespace(),
+				"scaleway_function_token":                       resourceScalewayFunctionToken(),
+				"scaleway_iam_api_key":                          resourceScalewayIamAPIKey(),
+				"scaleway_iam_application":                      resourceScalewayIamApplication(),
+				"scaleway_iam_group":                            resourceScalewayIamGroup(),
+				"scaleway_iam_policy":                           resourceScalewayIamPolicy(),
+				"scaleway_instance_user_data":                   resourceScalewayInstanceUserData(),
+				"scaleway_instance_image":                       resourceScalewayInstanceImage(),
+				"scaleway_instance_image_copy":                  resourceScalewayInstanceImageCopy(),
+				"scaleway_instance_ip":                          resourceScalewayInstanceIP(),
+				"scaleway_instance_ip_reverse_dns":              resourceScalewayInstanceIPReverseDNS(),
+				"scaleway_instance_volume":                      resourceScalewayInstanceVolume(),
+				"scaleway_instance_security_group":              resourceScalewayInstanceSecurityGroup(),
+				"scaleway_instance_security_group_rule":         resourceScalewayInstanceSecurityGroupRule(),
+				"scaleway_instance_security_group_rules":        resourceScalewayInstanceSecurityGroupRules(),
+				"scaleway_instance_server":                      resourceScalewayInstanceServer(),
+				"scaleway_instance_server_group":                resourceScalewayInstanceServerGroup(),
+				"scaleway_instance_snapshot":                    resourceScalewayInstanceSnapshot(),
+				"scaleway_instance_snapshot_export":             resourceScalewayInstanceSnapshotExport(),
+				"scaleway_iam_ssh_key":                          resourceScalewayIamSSKKey(),
+				"scaleway_instance_placement_group":             resourceScalewayInstancePlacementGroup(),
+				"scaleway_instance_private_nic":                 resourceScalewayInstancePrivateNIC(),
+				"scaleway_iot_hub":                              resourceScalewayIotHub(),
+				"scaleway_iot_device":                           resourceScalewayIotDevice(),
+				"scaleway_iot_route":                            resourceScalewayIotRoute(),
+				"scaleway_iot_network":                          resourceScalewayIotNetwork(),
+				"scaleway_k8s_cluster":                          resourceScalewayK8SCluster(),
+				"scaleway_k8s_pool":                             resourceScalewayK8SPool(),
+				"scaleway_lb":                                   resourceScalewayLb(),
+				"scaleway_lb_acl":                               resourceScalewayLbACL(),
+				"scaleway_lb_ip":                                resourceScalewayLbIP(),
+				"scaleway_lb_backend":                           resourceScalewayLbBackend(),
+				"scaleway_lb_certificate":                       resourceScalewayLbCertificate(),
+				"scaleway_lb_frontend":                          resourceScalewayLbFrontend(),
+				"scaleway_lb_route":                             resourceScalewayLbRoute(),
+				"scaleway_registry_namespace":                   resourceScalewayRegistryNamespace(),
+				"scaleway_tem_domain":                           resourceScalewayTemDomain(),
+				"scaleway_container":                            resourceScalewayContainer(),
+				"scaleway_container_token":                      resourceScalewayContainerToken(),
+				"scaleway_rdb_acl":                              resourceScalewayRdbACL(),
+				"scaleway_rdb_database":                         resourceScalewayRdbDatabase(),
+				"scaleway_rdb_database_backup":                  resourceScalewayRdbDatabaseBackup(),
+				"scaleway_rdb_instance":                         resourceScalewayRdbInstance(),
+				"scaleway_rdb_privilege":                        resourceScalewayRdbPrivilege(),
+				"scaleway_rdb_user":                             resourceScalewayRdbUser(),
+				"scaleway_rdb_read_replica":                     resourceScalewayRdbReadReplica(),
+				"scaleway_redis_cluster":                        resourceScalewayRedisCluster(),
+				"scaleway_object":                               resourceScalewayObject(),
+				"scaleway_object_bucket":                        resourceScalewayObjectBucket(),
+				"scaleway_object_bucket_acl":                    resourceScalewayObjectBucketACL(),
+				"scaleway_object_bucket_lock_configuration":     resourceObjectLockConfiguration(),
+				"scaleway_object_bucket_policy":                 resourceScalewayObjectBucketPolicy(),
+				"scaleway_object_bucket_website_configuration":  ResourceBucketWebsiteConfiguration(),
+				"scaleway_mnq_namespace":                        resourceScalewayMNQNamespace(),
+				"scaleway_vpc_public_gateway":                   resourceScalewayVPCPublicGateway(),
+				"scaleway_vpc_gateway_network":                  resourceScalewayVPCGatewayNetwork(),
+				"scaleway_vpc_public_gateway_dhcp":              resourceScalewayVPCPublicGatewayDHCP(),
+				"scaleway_vpc_public_gateway_dhcp_reservation":  resourceScalewayVPCPublicGatewayDHCPReservation(),
+				"scaleway_vpc_public_gateway_dhcp_reservations": resourceScalewayVPCPublicGatewayDHCPReservations(),
+				"scaleway_vpc_public_gateway_ip":                resourceScalewayVPCPublicGatewayIP(),
+				"scaleway_vpc_public_gateway_pat_rule":          resourceScalewayVPCPublicGatewayPATRule(),
+				"scaleway_vpc_public_gateway_pat_rules":         resourceScalewayVPCPublicGatewayPATRules(),
+				"scaleway_vpc_private_network":                  resourceScalewayVPCPrivateNetwork(),
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
 				"scaleway_account_project":                     dataSourceScalewayAccountProject(),
 				"scaleway_account_ssh_key":                     dataSourceScalewayAccountSSHKey(),
 				"scaleway_baremetal_offer":                     dataSourceScalewayBaremetalOffer(),
+				"scaleway_baremetal_offers":                    dataSourceScalewayBaremetalOffers(),
 				"scaleway_baremetal_option":                    dataSourceScalewayBaremetalOption(),
 				"scaleway_baremetal_os":                        dataSourceScalewayBaremetalOs(),
 				"scaleway_baremetal_server":                    dataSourceScalewayBaremetalServer(),
@@ -176,6 +186,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_instance_ip":                         dataSourceScalewayInstanceIP(),
 				"scaleway_instance_security_group":             dataSourceScalewayInstanceSecurityGroup(),
 				"scaleway_instance_server":                     dataSourceScalewayInstanceServer(),
+				"scaleway_instance_server_console":             dataSourceScalewayInstanceServerConsole(),
 				"scaleway_instance_servers":                    dataSourceScalewayInstanceServers(),
 				"scaleway_instance_image":                      dataSourceScalewayInstanceImage(),
 				"scaleway_instance_volume":                     dataSourceScalewayInstanceVolume(),
@@ -185,6 +196,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_k8s_cluster":                         dataSourceScalewayK8SCluster(),
 				"scaleway_k8s_pool":                            dataSourceScalewayK8SPool(),
 				"scaleway_lb":                                  dataSourceScalewayLb(),
+				"scaleway_lb_backend_stats":                    dataSourceScalewayLbBackendStats(),
 				"scaleway_lb_certificate":                      dataSourceScalewayLbCertificate(),
 				"scaleway_lb_ip":                               dataSourceScalewayLbIP(),
 				"scaleway_marketplace_image":                   dataSourceScalewayMarketplaceImage(),
@@ -201,6 +213,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_vpc_public_gateway":                  dataSourceScalewayVPCPublicGateway(),
 				"scaleway_vpc_gateway_network":                 dataSourceScalewayVPCGatewayNetwork(),
 				"scaleway_vpc_public_gateway_dhcp":             dataSourceScalewayVPCPublicGatewayDHCP(),
+				"scaleway_vpc_public_gateway_dhcp_entries":     dataSourceScalewayVPCPublicGatewayDHCPEntries(),
 				"scaleway_vpc_public_gateway_dhcp_reservation": dataSourceScalewayVPCPublicGatewayDHCPReservation(),
 				"scaleway_vpc_public_gateway_ip":               dataSourceScalewayVPCPublicGatewayIP(),
 				"scaleway_vpc_private_network":                 dataSourceScalewayVPCPrivateNetwork(),
@@ -41,6 +41,16 @@ func resourceScalewayAppleSiliconServer() *schema.Resource {
 					AppleSiliconM1Type,
 				}, false),
 			},
+			"reboot_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reboot the server by changing this field's value",
+			},
+			"reinstall_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reinstall the OS of the server by changing this field's value",
+			},
 			// Computed
 			"ip": {
 				Type:        schema.TypeString,
@@ -78,9 +88,26 @@ func resourceScalewayAppleSiliconServer() *schema.Resource {
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
 		},
+		CustomizeDiff: resourceScalewayAppleSiliconServerCustomizeDiff,
 	}
 }
 
+// resourceScalewayAppleSiliconServerCustomizeDiff refuses to replace the server before deletable_at:
+// replacing the server deletes it, which the API refuses before this date.
+func resourceScalewayAppleSiliconServerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for key, keySchema := range resourceScalewayAppleSiliconServer().Schema {
+		if keySchema.ForceNew && diff.HasChange(key) {
+			return appleSiliconServerDeletableError(diff.Get("deletable_at").(string), time.Now())
+		}
+	}
+
+	return nil
+}
+
 func resourceScalewayAppleSiliconServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	asAPI, zone, err := asAPIWithZone(d, meta)
 	if err != nil {
@@ -162,6 +189,34 @@ func resourceScalewayAppleSiliconServerUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("reinstall_trigger") {
+		_, err = asAPI.ReinstallServer(&applesilicon.ReinstallServerRequest{
+			Zone:     zone,
+			ServerID: ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForAppleSiliconServer(ctx, asAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("reboot_trigger") {
+		_, err = asAPI.RebootServer(&applesilicon.RebootServerRequest{
+			Zone:     zone,
+			ServerID: ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForAppleSiliconServer(ctx, asAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayAppleSiliconServerRead(ctx, d, meta)
 }
 
@@ -171,6 +226,11 @@ func resourceScalewayAppleSiliconServerDelete(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	err = appleSiliconServerDeletableError(d.Get("deletable_at").(string), time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = asAPI.DeleteServer(&applesilicon.DeleteServerRequest{
 		Zone:     zone,
 		ServerID: ID,
@@ -1,13 +1,16 @@
 package scaleway
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	applesilicon "github.com/scaleway/scaleway-sdk-go/api/applesilicon/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -127,3 +130,69 @@ func testAccCheckScalewayAppleSiliconServerDestroy(tt *TestTools) resource.TestC
 		return nil
 	}
 }
+
+func TestAppleSiliconServerDeletableError(t *testing.T) {
+	now := time.Date(2021, 2, 5, 10, 12, 20, 0, time.UTC)
+
+	assert.NoError(t, appleSiliconServerDeletableError("", now))
+	assert.NoError(t, appleSiliconServerDeletableError("2021-02-05T10:12:20Z", now))
+	assert.NoError(t, appleSiliconServerDeletableError("2021-02-04T10:12:20Z", now))
+	assert.Error(t, appleSiliconServerDeletableError("2021-02-06T10:12:20Z", now))
+	assert.Error(t, appleSiliconServerDeletableError("not a date", now))
+}
+
+func TestAppleSiliconServerCustomizeDiff(t *testing.T) {
+	deletableAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	state := &terraform.InstanceState{
+		ID: "fr-par-3/11111111-1111-1111-1111-111111111111",
+		Attributes: map[string]string{
+			"id":           "fr-par-3/11111111-1111-1111-1111-111111111111",
+			"type":         AppleSiliconM1Type,
+			"zone":         "fr-par-3",
+			"project_id":   "22222222-2222-2222-2222-222222222222",
+			"deletable_at": deletableAt,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		deletableAt string
+		expectError bool
+	}{
+		{
+			name:        "in place update",
+			config:      map[string]interface{}{"type": AppleSiliconM1Type, "reboot_trigger": "1"},
+			deletableAt: deletableAt,
+		},
+		{
+			name:        "zone replacement before deletable_at",
+			config:      map[string]interface{}{"type": AppleSiliconM1Type, "zone": "fr-par-1"},
+			deletableAt: deletableAt,
+			expectError: true,
+		},
+		{
+			name:        "project replacement before deletable_at",
+			config:      map[string]interface{}{"type": AppleSiliconM1Type, "project_id": "33333333-3333-3333-3333-333333333333"},
+			deletableAt: deletableAt,
+			expectError: true,
+		},
+		{
+			name:        "zone replacement after deletable_at",
+			config:      map[string]interface{}{"type": AppleSiliconM1Type, "zone": "fr-par-1"},
+			deletableAt: "2021-02-05T10:12:20Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state.Attributes["deletable_at"] = tc.deletableAt
+			_, err := resourceScalewayAppleSiliconServer().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.expectError {
+				assert.ErrorContains(t, err, "can't be deleted before")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
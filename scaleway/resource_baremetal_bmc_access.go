@@ -0,0 +1,144 @@
+package scaleway
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayBaremetalBMCAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayBaremetalBMCAccessCreate,
+		ReadContext:   resourceScalewayBaremetalBMCAccessRead,
+		DeleteContext: resourceScalewayBaremetalBMCAccessDelete,
+		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultBaremetalBMCAccessTimeout),
+			Create:  schema.DefaultTimeout(defaultBaremetalBMCAccessTimeout),
+			Delete:  schema.DefaultTimeout(defaultBaremetalBMCAccessTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the server to access",
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "The IP authorized to connect to the BMC",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL of the remote console",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The login of the remote console",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the remote console",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time after which the BMC access is closed",
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func resourceScalewayBaremetalBMCAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zone, err := baremetalAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverID := expandID(d.Get("server_id"))
+	_, err = baremetalAPI.StartBMCAccess(&baremetal.StartBMCAccessRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		IP:       net.ParseIP(d.Get("ip").(string)),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedIDString(zone, serverID))
+
+	_, err = waitForBaremetalBMCAccess(ctx, baremetalAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayBaremetalBMCAccessRead(ctx, d, meta)
+}
+
+func resourceScalewayBaremetalBMCAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bmcAccess, err := baremetalAPI.GetBMCAccess(&baremetal.GetBMCAccessRequest{
+		Zone:     zonedID.Zone,
+		ServerID: zonedID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// An expired access is closed by the API, it has to be started again.
+	if baremetalBMCAccessExpired(bmcAccess, time.Now()) {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("server_id", zonedID.String())
+	_ = d.Set("url", bmcAccess.URL)
+	_ = d.Set("login", bmcAccess.Login)
+	_ = d.Set("password", bmcAccess.Password)
+	_ = d.Set("expires_at", flattenTime(bmcAccess.ExpiresAt))
+	_ = d.Set("zone", zonedID.Zone.String())
+
+	return nil
+}
+
+func resourceScalewayBaremetalBMCAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = baremetalAPI.StopBMCAccess(&baremetal.StopBMCAccessRequest{
+		Zone:     zonedID.Zone,
+		ServerID: zonedID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package scaleway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaremetalBMCAccessExpired(t *testing.T) {
+	now := time.Date(2022, 11, 2, 10, 0, 0, 0, time.UTC)
+	before, after := now.Add(-time.Minute), now.Add(time.Minute)
+
+	assert.False(t, baremetalBMCAccessExpired(&baremetal.BMCAccess{}, now))
+	assert.False(t, baremetalBMCAccessExpired(&baremetal.BMCAccess{ExpiresAt: &after}, now))
+	assert.True(t, baremetalBMCAccessExpired(&baremetal.BMCAccess{ExpiresAt: &before}, now))
+}
@@ -105,6 +105,26 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 				Default:     false,
 				Description: "If True, this boolean allows to reinstall the server on SSH key IDs, user or password changes",
 			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     baremetalServerStateStarted,
+				Description: "The state of the server should be: started, stopped",
+				ValidateFunc: validation.StringInSlice([]string{
+					baremetalServerStateStarted,
+					baremetalServerStateStopped,
+				}, false),
+			},
+			"boot_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     baremetal.ServerBootTypeNormal.String(),
+				Description: "The boot type of the server, changing it reboots the server, it can't be changed on a stopped server",
+				ValidateFunc: validation.StringInSlice([]string{
+					baremetal.ServerBootTypeNormal.String(),
+					baremetal.ServerBootTypeRescue.String(),
+				}, false),
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -243,7 +263,7 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 				return fmt.Errorf("private network option needs to be enabled in order to attach a private network")
 			}
 
-			return nil
+			return validateBaremetalServerBootType(diff)
 		},
 	}
 }
@@ -349,7 +369,13 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 		}
 	}
 
-	_, err = waitForBaremetalServerInstall(ctx, baremetalAPI, zone, server.ID, d.Timeout(schema.TimeoutCreate))
+	server, err = waitForBaremetalServerInstall(ctx, baremetalAPI, zone, server.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bootType := baremetal.ServerBootType(d.Get("boot_type").(string))
+	err = baremetalServerReachState(ctx, baremetalAPI, server, d.Get("state").(string), bootType, bootType != baremetal.ServerBootTypeNormal, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -404,6 +430,12 @@ func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resource
 	}
 	_ = d.Set("description", server.Description)
 	_ = d.Set("options", flattenBaremetalOptions(server.Zone, server.Options))
+	if state := flattenBaremetalServerState(server.Status); state != "" {
+		_ = d.Set("state", state)
+	}
+	if server.BootType == baremetal.ServerBootTypeNormal || server.BootType == baremetal.ServerBootTypeRescue {
+		_ = d.Set("boot_type", server.BootType.String())
+	}
 
 	listPrivateNetworks, err := baremetalPrivateNetworkAPI.ListServerPrivateNetworks(&baremetal.PrivateNetworkAPIListServerPrivateNetworksRequest{
 		Zone:     server.Zone,
@@ -582,6 +614,18 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChanges("state", "boot_type") {
+		server, err = waitForBaremetalServer(ctx, baremetalAPI, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = baremetalServerReachState(ctx, baremetalAPI, server, d.Get("state").(string), baremetal.ServerBootType(d.Get("boot_type").(string)), d.HasChange("boot_type"), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return append(diags, resourceScalewayBaremetalServerRead(ctx, d, meta)...)
 }
 
@@ -1,14 +1,17 @@
 package scaleway
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
 )
 
 const SSHKeyBaremetal = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIM7HUxRyQtB2rnlhQUcbDGCZcTJg7OvoznOiyC9W6IxH opensource@scaleway.com"
@@ -870,3 +873,89 @@ func testAccCheckScalewayBaremetalServerHasPrivateNetwork(tt *TestTools, n strin
 		return nil
 	}
 }
+
+func TestFlattenBaremetalServerState(t *testing.T) {
+	assert.Equal(t, baremetalServerStateStarted, flattenBaremetalServerState(baremetal.ServerStatusReady))
+	assert.Equal(t, baremetalServerStateStopped, flattenBaremetalServerState(baremetal.ServerStatusStopped))
+	assert.Equal(t, "", flattenBaremetalServerState(baremetal.ServerStatusStarting))
+	assert.Equal(t, "", flattenBaremetalServerState(baremetal.ServerStatusStopping))
+}
+
+func TestBaremetalServerStateAction(t *testing.T) {
+	assert.Equal(t, baremetalServerActionStop, baremetalServerStateAction(baremetal.ServerStatusReady, baremetalServerStateStopped, false))
+	assert.Equal(t, baremetalServerActionNone, baremetalServerStateAction(baremetal.ServerStatusStopped, baremetalServerStateStopped, true))
+	assert.Equal(t, baremetalServerActionStart, baremetalServerStateAction(baremetal.ServerStatusStopped, baremetalServerStateStarted, false))
+	assert.Equal(t, baremetalServerActionStart, baremetalServerStateAction(baremetal.ServerStatusStopped, baremetalServerStateStarted, true))
+	assert.Equal(t, baremetalServerActionReboot, baremetalServerStateAction(baremetal.ServerStatusReady, baremetalServerStateStarted, true))
+	assert.Equal(t, baremetalServerActionNone, baremetalServerStateAction(baremetal.ServerStatusReady, baremetalServerStateStarted, false))
+}
+
+func TestValidateBaremetalServerBootType(t *testing.T) {
+	serverSchema := resourceScalewayBaremetalServer().Schema
+	bootTypeResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"state":     serverSchema["state"],
+			"boot_type": serverSchema["boot_type"],
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return validateBaremetalServerBootType(diff)
+		},
+	}
+	server := func(state, bootType string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID:         "fr-par-2/11111111-1111-1111-1111-111111111111",
+			Attributes: map[string]string{"id": "fr-par-2/11111111-1111-1111-1111-111111111111", "state": state, "boot_type": bootType},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		state       *terraform.InstanceState
+		config      map[string]interface{}
+		expectError bool
+	}{
+		{
+			name:   "create a stopped server with the normal boot type",
+			state:  &terraform.InstanceState{},
+			config: map[string]interface{}{"state": "stopped"},
+		},
+		{
+			name:        "create a stopped server in rescue mode",
+			state:       &terraform.InstanceState{},
+			config:      map[string]interface{}{"state": "stopped", "boot_type": "rescue"},
+			expectError: true,
+		},
+		{
+			name:   "reboot a started server in rescue mode",
+			state:  server("started", "normal"),
+			config: map[string]interface{}{"state": "started", "boot_type": "rescue"},
+		},
+		{
+			name:   "start a stopped server in rescue mode",
+			state:  server("stopped", "normal"),
+			config: map[string]interface{}{"state": "started", "boot_type": "rescue"},
+		},
+		{
+			name:        "change the boot type of a stopped server",
+			state:       server("stopped", "normal"),
+			config:      map[string]interface{}{"state": "stopped", "boot_type": "rescue"},
+			expectError: true,
+		},
+		{
+			name:   "stop a server",
+			state:  server("started", "rescue"),
+			config: map[string]interface{}{"state": "stopped", "boot_type": "rescue"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := bootTypeResource.SimpleDiff(context.Background(), tc.state, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.expectError {
+				assert.ErrorContains(t, err, "can't be applied to a stopped server")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -32,10 +32,16 @@ func resourceScalewayFlexibleIP() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the flexible IP",
 			},
+			"is_ipv6": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Defines whether the flexible IP has an IPv6 address",
+			},
 			"ip_address": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The IPv4 address of the flexible IP",
+				Description: "The IP address of the flexible IP",
 			},
 			"reverse": {
 				Type:        schema.TypeString,
@@ -90,6 +96,7 @@ func resourceScalewayFlexibleIPCreate(ctx context.Context, d *schema.ResourceDat
 		Tags:        expandStrings(d.Get("tags")),
 		ServerID:    expandStringPtr(expandID(d.Get("server_id"))),
 		Reverse:     expandStringPtr(d.Get("reverse")),
+		IsIPv6:      d.Get("is_ipv6").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -130,6 +137,7 @@ func resourceScalewayFlexibleIPRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	_ = d.Set("ip_address", flexibleIP.IPAddress.String())
+	_ = d.Set("is_ipv6", flexibleIP.IPAddress.IP.To4() == nil)
 	_ = d.Set("zone", flexibleIP.Zone)
 	_ = d.Set("organization_id", flexibleIP.OrganizationID)
 	_ = d.Set("project_id", flexibleIP.ProjectID)
@@ -143,6 +151,12 @@ func resourceScalewayFlexibleIPRead(ctx context.Context, d *schema.ResourceData,
 		_ = d.Set("server_id", "")
 	}
 
+	if flexibleIP.MacAddress != nil {
+		_ = d.Set("mac_address", flexibleIP.MacAddress.MacAddress)
+	} else {
+		_ = d.Set("mac_address", "")
+	}
+
 	return nil
 }
 
@@ -191,7 +205,8 @@ func resourceScalewayFlexibleIPUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if d.HasChange("server_id") {
-		if _, serverIDExists := d.GetOk("server_id"); !serverIDExists {
+		oldServerID, newServerID := d.GetChange("server_id")
+		if oldServerID.(string) != "" {
 			_, err = fipAPI.DetachFlexibleIP(&flexibleip.DetachFlexibleIPRequest{
 				Zone:    zone,
 				FipsIDs: []string{ID},
@@ -199,7 +214,15 @@ func resourceScalewayFlexibleIPUpdate(ctx context.Context, d *schema.ResourceDat
 			if err != nil {
 				return diag.FromErr(err)
 			}
-		} else {
+		}
+		if newServerID.(string) != "" {
+			// Moving a flexible IP to another server requires it to be detached first.
+			if oldServerID.(string) != "" {
+				_, err = waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
 			_, err = fipAPI.AttachFlexibleIP(&flexibleip.AttachFlexibleIPRequest{
 				Zone:     zone,
 				FipsIDs:  []string{ID},
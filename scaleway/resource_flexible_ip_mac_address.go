@@ -0,0 +1,258 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayFlexibleIPMACAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayFlexibleIPMACAddressCreate,
+		ReadContext:   resourceScalewayFlexibleIPMACAddressRead,
+		UpdateContext: resourceScalewayFlexibleIPMACAddressUpdate,
+		DeleteContext: resourceScalewayFlexibleIPMACAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Read:    schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Update:  schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Delete:  schema.DefaultTimeout(defaultFlexibleIPTimeout),
+			Default: schema.DefaultTimeout(defaultFlexibleIPTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"flexible_ip_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The ID of the flexible IP holding the virtual MAC address, changing it moves the virtual MAC address",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					flexibleip.MACAddressTypeKvm.String(),
+					flexibleip.MACAddressTypeVmware.String(),
+					flexibleip.MACAddressTypeXen.String(),
+				}, false),
+				Description: "The type of the virtual MAC address",
+			},
+			"flexible_ip_ids_to_duplicate": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the flexible IPs, attached to the same server, the virtual MAC address is duplicated on",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validationUUIDorUUIDWithLocality(),
+					DiffSuppressFunc: diffSuppressFuncLocality,
+				},
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual MAC address",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the virtual MAC address",
+			},
+			"zone": zoneSchema(),
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the creation of the virtual MAC address (Format ISO 8601)",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the virtual MAC address (Format ISO 8601)",
+			},
+		},
+	}
+}
+
+func resourceScalewayFlexibleIPMACAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, err := fipAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fipID := expandID(d.Get("flexible_ip_id"))
+	_, err = waitFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = fipAPI.GenerateMACAddr(&flexibleip.GenerateMACAddrRequest{
+		Zone:    zone,
+		FipID:   fipID,
+		MacType: flexibleip.MACAddressType(d.Get("type").(string)),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if flexibleIP.MacAddress == nil {
+		return diag.FromErr(fmt.Errorf("no virtual MAC address generated on flexible IP %s", fipID))
+	}
+
+	d.SetId(newZonedIDString(zone, flexibleIP.MacAddress.ID))
+
+	for _, duplicateID := range expandStrings(d.Get("flexible_ip_ids_to_duplicate").(*schema.Set).List()) {
+		err = duplicateFlexibleIPMACAddress(ctx, fipAPI, zone, fipID, expandID(duplicateID), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayFlexibleIPMACAddressRead(ctx, d, meta)
+}
+
+func resourceScalewayFlexibleIPMACAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, ID, err := fipAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var flexibleIP *flexibleip.FlexibleIP
+	if fipID, ok := d.GetOk("flexible_ip_id"); ok {
+		flexibleIP, err = fipAPI.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
+			Zone:  zone,
+			FipID: expandID(fipID),
+		}, scw.WithContext(ctx))
+	} else {
+		// The flexible IP is unknown when importing the resource.
+		flexibleIP, err = findFlexibleIPByMACAddressID(ctx, fipAPI, zone, ID)
+	}
+	if err != nil {
+		// We check for 403 because flexible API returns 403 for a deleted IP
+		if is404Error(err) || is403Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if flexibleIP == nil || flexibleIP.MacAddress == nil || flexibleIP.MacAddress.ID != ID {
+		d.SetId("")
+		return nil
+	}
+
+	duplicateIDs := []string(nil)
+	for _, duplicateID := range expandStrings(d.Get("flexible_ip_ids_to_duplicate").(*schema.Set).List()) {
+		duplicate, err := fipAPI.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
+			Zone:  zone,
+			FipID: expandID(duplicateID),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) || is403Error(err) {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		if duplicate.MacAddress != nil && duplicate.MacAddress.MacAddress == flexibleIP.MacAddress.MacAddress {
+			duplicateIDs = append(duplicateIDs, duplicateID)
+		}
+	}
+
+	_ = d.Set("flexible_ip_id", newZonedIDString(zone, flexibleIP.ID))
+	_ = d.Set("type", flexibleIP.MacAddress.MacType.String())
+	_ = d.Set("flexible_ip_ids_to_duplicate", duplicateIDs)
+	_ = d.Set("address", flexibleIP.MacAddress.MacAddress)
+	_ = d.Set("status", flexibleIP.MacAddress.Status.String())
+	_ = d.Set("zone", zone)
+	_ = d.Set("created_at", flattenTime(flexibleIP.MacAddress.CreatedAt))
+	_ = d.Set("updated_at", flattenTime(flexibleIP.MacAddress.UpdatedAt))
+
+	return nil
+}
+
+func resourceScalewayFlexibleIPMACAddressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, _, err := fipAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("flexible_ip_id") {
+		oldFipID, newFipID := d.GetChange("flexible_ip_id")
+		for _, fipID := range []string{expandID(oldFipID), expandID(newFipID)} {
+			_, err = waitFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		_, err = fipAPI.MoveMACAddr(&flexibleip.MoveMACAddrRequest{
+			Zone:     zone,
+			FipID:    expandID(oldFipID),
+			DstFipID: expandID(newFipID),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, expandID(newFipID), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if flexibleIP.MacAddress != nil {
+			d.SetId(newZonedIDString(zone, flexibleIP.MacAddress.ID))
+		}
+	}
+
+	if d.HasChange("flexible_ip_ids_to_duplicate") {
+		fipID := expandID(d.Get("flexible_ip_id"))
+		oldDuplicates, newDuplicates := d.GetChange("flexible_ip_ids_to_duplicate")
+
+		for _, duplicateID := range expandStrings(oldDuplicates.(*schema.Set).Difference(newDuplicates.(*schema.Set)).List()) {
+			err = deleteFlexibleIPMACAddress(ctx, fipAPI, zone, expandID(duplicateID), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, duplicateID := range expandStrings(newDuplicates.(*schema.Set).Difference(oldDuplicates.(*schema.Set)).List()) {
+			err = duplicateFlexibleIPMACAddress(ctx, fipAPI, zone, fipID, expandID(duplicateID), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceScalewayFlexibleIPMACAddressRead(ctx, d, meta)
+}
+
+func resourceScalewayFlexibleIPMACAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fipAPI, zone, _, err := fipAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, duplicateID := range expandStrings(d.Get("flexible_ip_ids_to_duplicate").(*schema.Set).List()) {
+		err = deleteFlexibleIPMACAddress(ctx, fipAPI, zone, expandID(duplicateID), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = deleteFlexibleIPMACAddress(ctx, fipAPI, zone, expandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -183,7 +183,7 @@ func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.Resource
 		RootVolume: expandZonedID(d.Get("root_volume_id").(string)).ID,
 		Arch:       instance.Arch(d.Get("architecture").(string)),
 		Project:    expandStringPtr(d.Get("project_id")),
-		Public:     false,
+		Public:     scw.BoolPtr(false),
 	}
 
 	extraVolumesIds, volumesExist := d.GetOk("additional_volume_ids")
@@ -199,7 +199,7 @@ func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.Resource
 		req.Tags = expandStrings(tags)
 	}
 	if isPublic := d.Get("public"); isPublic == true {
-		req.Public = true
+		req.Public = scw.BoolPtr(true)
 	}
 
 	res, err := instanceAPI.CreateImage(req, scw.WithContext(ctx))
@@ -0,0 +1,526 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceImageCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceImageCopyCreate,
+		ReadContext:   resourceScalewayInstanceImageCopyRead,
+		UpdateContext: resourceScalewayInstanceImageCopyUpdate,
+		DeleteContext: resourceScalewayInstanceImageCopyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Update:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Delete:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Default: schema.DefaultTimeout(defaultInstanceImageTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the image to copy",
+			},
+			"zones": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateStringInSliceWithWarning(allZones(), "zone"),
+				},
+				Description: "The zones the image is copied to",
+			},
+			"buckets": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The buckets, indexed by region, used to transfer the snapshots of the image. A bucket is required in the region of the image and in the region of each zone",
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "instance-image-copy",
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The prefix of the keys of the transferred snapshot objects",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the copied images, defaults to the name of the source image",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The tags of the copied images",
+			},
+			"image_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the copied images, indexed by zone",
+			},
+			"zone":       zoneSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceImageCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageZonedID := datasourceNewZonedID(d.Get("image_id"), zone)
+	d.SetId(imageZonedID)
+
+	err = copyInstanceImageToZones(ctx, d, meta, instanceAPI, expandInstanceImageCopyZones(d.Get("zones")), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayInstanceImageCopyRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceImageCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, imageID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	copies := map[scw.Zone]*instance.Image{}
+	for _, rawImageID := range d.Get("image_ids").(map[string]interface{}) {
+		copyZone, copyID, err := parseZonedID(rawImageID.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		res, err := instanceAPI.GetImage(&instance.GetImageRequest{
+			Zone:    copyZone,
+			ImageID: copyID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				// The zone is removed so that the copy is made again on next apply.
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		copies[copyZone] = res.Image
+		_ = d.Set("project_id", res.Image.Project)
+	}
+
+	imageIDs, zones := flattenInstanceImageCopies(copies)
+	_ = d.Set("image_id", newZonedIDString(zone, imageID))
+	_ = d.Set("image_ids", imageIDs)
+	_ = d.Set("zones", zones)
+	_ = d.Set("zone", zone)
+
+	return nil
+}
+
+func resourceScalewayInstanceImageCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI := instance.NewAPI(meta.(*Meta).scwClient)
+
+	if d.HasChange("zones") {
+		oldZones, newZones := d.GetChange("zones")
+
+		imageIDs := d.Get("image_ids").(map[string]interface{})
+		for _, removedZone := range expandInstanceImageCopyZones(oldZones.(*schema.Set).Difference(newZones.(*schema.Set))) {
+			rawImageID, ok := imageIDs[removedZone.String()]
+			if !ok {
+				continue
+			}
+			err := deleteInstanceImageCopy(ctx, instanceAPI, rawImageID.(string), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			delete(imageIDs, removedZone.String())
+			_ = d.Set("image_ids", imageIDs)
+		}
+
+		addedZones := expandInstanceImageCopyZones(newZones.(*schema.Set).Difference(oldZones.(*schema.Set)))
+		err := copyInstanceImageToZones(ctx, d, meta, instanceAPI, addedZones, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayInstanceImageCopyRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceImageCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI := instance.NewAPI(meta.(*Meta).scwClient)
+
+	for _, rawImageID := range d.Get("image_ids").(map[string]interface{}) {
+		err := deleteInstanceImageCopy(ctx, instanceAPI, rawImageID.(string), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// copyInstanceImageToZones exports the snapshots of the image to the bucket of its region,
+// copies them to the bucket of each target region and imports them as a new image in each zone.
+// The transferred objects are removed once the copies are done.
+func copyInstanceImageToZones(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceAPI *instance.API, zones []scw.Zone, timeout time.Duration) error {
+	if len(zones) == 0 {
+		return nil
+	}
+
+	sourceZone, sourceImageID, err := parseZonedID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	image, snapshots, err := getInstanceImageSnapshots(ctx, instanceAPI, sourceZone, sourceImageID)
+	if err != nil {
+		return err
+	}
+
+	buckets := *expandMapPtrStringString(d.Get("buckets"))
+	sourceRegion, err := sourceZone.Region()
+	if err != nil {
+		return err
+	}
+	sourceS3, sourceBucket, err := instanceImageCopyBucket(meta, buckets, sourceRegion)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, len(snapshots))
+	for i, snapshot := range snapshots {
+		keys[i] = fmt.Sprintf("%s/%s/%s.qcow2", d.Get("key_prefix").(string), sourceImageID, snapshot.ID)
+	}
+
+	// Objects are removed even if a copy fails, they are only needed during the transfer.
+	transferredObjects := map[scw.Region]bool{}
+	defer func() {
+		for region := range transferredObjects {
+			s3Client, bucket, err := instanceImageCopyBucket(meta, buckets, region)
+			if err != nil {
+				continue
+			}
+			for _, key := range keys {
+				_, err = s3Client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+					Bucket: aws.String(bucket),
+					Key:    aws.String(key),
+				})
+				if err != nil {
+					tflog.Warn(ctx, fmt.Sprintf("failed to delete transferred snapshot %s/%s: %s", bucket, key, err))
+				}
+			}
+		}
+	}()
+
+	transferredObjects[sourceRegion] = true
+	for i, snapshot := range snapshots {
+		err = exportInstanceSnapshot(ctx, instanceAPI, sourceZone, snapshot.ID, sourceBucket, keys[i], timeout)
+		if err != nil {
+			return err
+		}
+		err = waitForInstanceSnapshotObject(ctx, instanceAPI, sourceS3, sourceZone, snapshot.ID, sourceBucket, keys[i], timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	name := expandOrGenerateString(d.Get("name"), image.Name)
+	_ = d.Set("name", name)
+	imageIDs := d.Get("image_ids").(map[string]interface{})
+
+	for _, zone := range zones {
+		region, err := zone.Region()
+		if err != nil {
+			return err
+		}
+		s3Client, bucket, err := instanceImageCopyBucket(meta, buckets, region)
+		if err != nil {
+			return err
+		}
+
+		if !transferredObjects[region] {
+			transferredObjects[region] = true
+			for _, key := range keys {
+				err = copyInstanceImageObject(ctx, sourceS3, sourceBucket, s3Client, bucket, key)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		imageID, err := importInstanceImage(ctx, instanceAPI, d, zone, image, snapshots, name, bucket, keys, timeout)
+		if err != nil {
+			return err
+		}
+
+		imageIDs[zone.String()] = newZonedIDString(zone, imageID)
+		_ = d.Set("image_ids", imageIDs)
+	}
+
+	return nil
+}
+
+// getInstanceImageSnapshots returns an image and its snapshots, starting with the root volume followed by the extra volumes.
+func getInstanceImageSnapshots(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, imageID string) (*instance.Image, []*instance.Snapshot, error) {
+	res, err := instanceAPI.GetImage(&instance.GetImageRequest{
+		Zone:    zone,
+		ImageID: imageID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotIDs := instanceImageSnapshotIDs(res.Image)
+	snapshots := make([]*instance.Snapshot, 0, len(snapshotIDs))
+	for _, snapshotID := range snapshotIDs {
+		snapshot, err := instanceAPI.GetSnapshot(&instance.GetSnapshotRequest{
+			Zone:       zone,
+			SnapshotID: snapshotID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshots = append(snapshots, snapshot.Snapshot)
+	}
+
+	return res.Image, snapshots, nil
+}
+
+// instanceImageSnapshotIDs returns the IDs of the snapshots of an image, the root volume first then the extra volumes by key.
+func instanceImageSnapshotIDs(image *instance.Image) []string {
+	snapshotIDs := []string{image.RootVolume.ID}
+	extraVolumeKeys := make([]string, 0, len(image.ExtraVolumes))
+	for key := range image.ExtraVolumes {
+		extraVolumeKeys = append(extraVolumeKeys, key)
+	}
+	sort.Strings(extraVolumeKeys)
+	for _, key := range extraVolumeKeys {
+		snapshotIDs = append(snapshotIDs, image.ExtraVolumes[key].ID)
+	}
+	return snapshotIDs
+}
+
+// flattenInstanceImageCopies returns the image_ids and zones of the copies that still exist, zones sorted.
+func flattenInstanceImageCopies(copies map[scw.Zone]*instance.Image) (map[string]interface{}, []string) {
+	imageIDs := make(map[string]interface{}, len(copies))
+	zones := make([]string, 0, len(copies))
+	for zone, image := range copies {
+		imageIDs[zone.String()] = newZonedIDString(zone, image.ID)
+		zones = append(zones, zone.String())
+	}
+	sort.Strings(zones)
+	return imageIDs, zones
+}
+
+// importInstanceImage imports the transferred snapshots in a zone and creates an image from them.
+// The imported snapshots are removed if the image can't be created.
+func importInstanceImage(ctx context.Context, instanceAPI *instance.API, d *schema.ResourceData, zone scw.Zone, image *instance.Image, snapshots []*instance.Snapshot, name string, bucket string, keys []string, timeout time.Duration) (imageID string, err error) {
+	importedSnapshots := make([]*instance.Snapshot, 0, len(snapshots))
+	defer func() {
+		if err == nil {
+			return
+		}
+		if imageID != "" {
+			deleteErr := instanceAPI.DeleteImage(&instance.DeleteImageRequest{
+				Zone:    zone,
+				ImageID: imageID,
+			}, scw.WithContext(ctx))
+			if deleteErr != nil && !is404Error(deleteErr) {
+				tflog.Warn(ctx, fmt.Sprintf("failed to delete image %s: %s", imageID, deleteErr))
+			}
+		}
+		for _, snapshot := range importedSnapshots {
+			deleteErr := instanceAPI.DeleteSnapshot(&instance.DeleteSnapshotRequest{
+				Zone:       zone,
+				SnapshotID: snapshot.ID,
+			}, scw.WithContext(ctx))
+			if deleteErr != nil && !is404Error(deleteErr) {
+				tflog.Warn(ctx, fmt.Sprintf("failed to delete imported snapshot %s: %s", snapshot.ID, deleteErr))
+			}
+		}
+	}()
+
+	for i, snapshot := range snapshots {
+		size := snapshot.Size
+		res, err := instanceAPI.CreateSnapshot(&instance.CreateSnapshotRequest{
+			Zone:       zone,
+			Name:       snapshot.Name,
+			Project:    expandStringPtr(d.Get("project_id")),
+			VolumeType: instance.SnapshotVolumeType(snapshot.VolumeType),
+			Bucket:     scw.StringPtr(bucket),
+			Key:        scw.StringPtr(keys[i]),
+			Size:       &size,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		importedSnapshots = append(importedSnapshots, res.Snapshot)
+
+		imported, err := waitForInstanceSnapshot(ctx, instanceAPI, zone, res.Snapshot.ID, timeout)
+		if err != nil {
+			return "", err
+		}
+		if imported.State != instance.SnapshotStateAvailable {
+			return "", fmt.Errorf("import of snapshot %s in %s failed, snapshot is %s", snapshot.ID, zone, imported.State)
+		}
+	}
+
+	extraVolumes := map[string]*instance.VolumeTemplate{}
+	for i, snapshot := range importedSnapshots[1:] {
+		extraVolumes[fmt.Sprint(i+1)] = &instance.VolumeTemplate{
+			ID:         snapshot.ID,
+			Name:       snapshot.Name,
+			Size:       snapshot.Size,
+			VolumeType: snapshot.VolumeType,
+		}
+	}
+
+	req := &instance.CreateImageRequest{
+		Zone:         zone,
+		Name:         name,
+		RootVolume:   importedSnapshots[0].ID,
+		Arch:         image.Arch,
+		ExtraVolumes: extraVolumes,
+		Project:      expandStringPtr(d.Get("project_id")),
+		Public:       scw.BoolPtr(false),
+	}
+	if tags := expandStrings(d.Get("tags")); len(tags) > 0 {
+		req.Tags = tags
+	}
+
+	res, err := instanceAPI.CreateImage(req, scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	// The image is returned on failure so that it is deleted along with its snapshots.
+	_, err = waitForInstanceImage(ctx, instanceAPI, zone, res.Image.ID, timeout)
+	if err != nil {
+		return res.Image.ID, err
+	}
+
+	return res.Image.ID, nil
+}
+
+// deleteInstanceImageCopy deletes a copied image along with the snapshots imported for it.
+func deleteInstanceImageCopy(ctx context.Context, instanceAPI *instance.API, zonedImageID string, timeout time.Duration) error {
+	zone, imageID, err := parseZonedID(zonedImageID)
+	if err != nil {
+		return err
+	}
+
+	image, err := waitForInstanceImage(ctx, instanceAPI, zone, imageID, timeout)
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return err
+	}
+
+	err = instanceAPI.DeleteImage(&instance.DeleteImageRequest{
+		Zone:    zone,
+		ImageID: imageID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return err
+	}
+
+	snapshotIDs := []string{image.RootVolume.ID}
+	for _, volume := range image.ExtraVolumes {
+		snapshotIDs = append(snapshotIDs, volume.ID)
+	}
+	for _, snapshotID := range snapshotIDs {
+		err = instanceAPI.DeleteSnapshot(&instance.DeleteSnapshotRequest{
+			Zone:       zone,
+			SnapshotID: snapshotID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instanceImageCopyBucket returns the bucket used to transfer snapshots in a region and an s3 client for this region.
+func instanceImageCopyBucket(meta interface{}, buckets map[string]string, region scw.Region) (*s3.S3, string, error) {
+	bucket, ok := buckets[region.String()]
+	if !ok || bucket == "" {
+		return nil, "", fmt.Errorf("no bucket defined in buckets for region %s", region)
+	}
+
+	s3Client, err := newS3ClientFromMetaWithRegion(meta.(*Meta), region)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s3Client, bucket, nil
+}
+
+// copyInstanceImageObject streams an object from a bucket to a bucket of another region,
+// as object storage can't copy objects between regions.
+func copyInstanceImageObject(ctx context.Context, src *s3.S3, srcBucket string, dst *s3.S3, dstBucket string, key string) error {
+	object, err := src.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(srcBucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read %s/%s: %w", srcBucket, key, err)
+	}
+	defer object.Body.Close()
+
+	_, err = s3manager.NewUploaderWithClient(dst).UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(dstBucket),
+		Key:    aws.String(key),
+		Body:   object.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s/%s: %w", dstBucket, key, err)
+	}
+
+	return nil
+}
+
+func expandInstanceImageCopyZones(rawZones interface{}) []scw.Zone {
+	zones := []scw.Zone(nil)
+	for _, zone := range expandStrings(rawZones.(*schema.Set).List()) {
+		zones = append(zones, scw.Zone(zone))
+	}
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i] < zones[j]
+	})
+
+	return zones
+}
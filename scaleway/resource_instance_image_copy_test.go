@@ -0,0 +1,44 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceImageSnapshotIDs(t *testing.T) {
+	image := &instance.Image{
+		RootVolume: &instance.VolumeSummary{ID: "root"},
+		ExtraVolumes: map[string]*instance.Volume{
+			"2":  {ID: "second"},
+			"1":  {ID: "first"},
+			"10": {ID: "tenth"},
+		},
+	}
+
+	// extra volumes are sorted by key, as strings
+	assert.Equal(t, []string{"root", "first", "tenth", "second"}, instanceImageSnapshotIDs(image))
+	assert.Equal(t, []string{"root"}, instanceImageSnapshotIDs(&instance.Image{RootVolume: &instance.VolumeSummary{ID: "root"}}))
+}
+
+func TestExpandInstanceImageCopyZones(t *testing.T) {
+	zones := expandInstanceImageCopyZones(schema.NewSet(schema.HashString, []interface{}{"nl-ams-1", "fr-par-2", "fr-par-1"}))
+	assert.Equal(t, []scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneNlAms1}, zones)
+
+	assert.Empty(t, expandInstanceImageCopyZones(schema.NewSet(schema.HashString, nil)))
+}
+
+func TestFlattenInstanceImageCopies(t *testing.T) {
+	imageIDs, zones := flattenInstanceImageCopies(map[scw.Zone]*instance.Image{
+		scw.ZoneNlAms1: {ID: "22222222-2222-2222-2222-222222222222"},
+		scw.ZoneFrPar2: {ID: "11111111-1111-1111-1111-111111111111"},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"fr-par-2": "fr-par-2/11111111-1111-1111-1111-111111111111",
+		"nl-ams-1": "nl-ams-1/22222222-2222-2222-2222-222222222222",
+	}, imageIDs)
+	assert.Equal(t, []string{"fr-par-2", "nl-ams-1"}, zones)
+}
@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +25,7 @@ func resourceScalewayInstanceSecurityGroup() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultInstanceSecurityGroupTimeout),
 		},
+		CustomizeDiff: customizeDiffSecurityGroupRules,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -63,24 +65,22 @@ func resourceScalewayInstanceSecurityGroup() *schema.Resource {
 				}, false),
 			},
 			"inbound_rule": {
-				Type:          schema.TypeList,
-				Optional:      true,
-				Description:   "Inbound rules for this security group",
-				Elem:          securityGroupRuleSchema(),
-				ConflictsWith: []string{"external_rules"},
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Inbound rules for this security group",
+				Elem:        securityGroupRuleSchema(),
 			},
 			"outbound_rule": {
-				Type:          schema.TypeList,
-				Optional:      true,
-				Description:   "Outbound rules for this security group",
-				Elem:          securityGroupRuleSchema(),
-				ConflictsWith: []string{"external_rules"},
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Outbound rules for this security group",
+				Elem:        securityGroupRuleSchema(),
 			},
 			"external_rules": {
-				Type:          schema.TypeBool,
-				Optional:      true,
-				Default:       false,
-				ConflictsWith: []string{"inbound_rule", "outbound_rule"},
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Tolerate rules managed outside of this resource, only the rules set in inbound_rule and outbound_rule are managed",
 			},
 			"enable_default_security": {
 				Type:        schema.TypeBool,
@@ -131,6 +131,10 @@ func resourceScalewayInstanceSecurityGroupCreate(ctx context.Context, d *schema.
 	d.SetId(newZonedIDString(zone, res.SecurityGroup.ID))
 
 	if d.Get("external_rules").(bool) {
+		err = updateSecurityGroupOwnedRules(ctx, instanceAPI, zone, res.SecurityGroup.ID, nil, securityGroupStateRules(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		return resourceScalewayInstanceSecurityGroupRead(ctx, d, meta)
 	}
 	// We call update instead of read as it will take care of creating rules.
@@ -166,14 +170,17 @@ func resourceScalewayInstanceSecurityGroupRead(ctx context.Context, d *schema.Re
 	_ = d.Set("enable_default_security", res.SecurityGroup.EnableDefaultSecurity)
 	_ = d.Set("tags", res.SecurityGroup.Tags)
 
-	if !d.Get("external_rules").(bool) {
-		inboundRules, outboundRules, err := getSecurityGroupRules(ctx, instanceAPI, zone, ID, d)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		_ = d.Set("inbound_rule", inboundRules)
-		_ = d.Set("outbound_rule", outboundRules)
+	getRules := getSecurityGroupRules
+	if d.Get("external_rules").(bool) {
+		getRules = getSecurityGroupOwnedRules
 	}
+	inboundRules, outboundRules, err := getRules(ctx, instanceAPI, zone, ID, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("inbound_rule", inboundRules)
+	_ = d.Set("outbound_rule", outboundRules)
+
 	return nil
 }
 
@@ -207,30 +214,9 @@ func getSecurityGroupRules(ctx context.Context, instanceAPI *instance.API, zone
 
 	// We make sure that we keep state rule if they match their api rule.
 	for direction := range apiRules {
-		for index, apiRule := range apiRules[direction] {
-			if index < len(stateRules[direction]) {
-				stateRule, errGroup := securityGroupRuleExpand(stateRules[direction][index])
-				if errGroup != nil {
-					return nil, nil, errGroup
-				}
-				if ok, _ := securityGroupRuleEquals(stateRule, apiRule); !ok {
-					stateRules[direction][index], err = securityGroupRuleFlatten(apiRule)
-					if err != nil {
-						return nil, nil, err
-					}
-				}
-			} else {
-				rulesGroup, err := securityGroupRuleFlatten(apiRule)
-				if err != nil {
-					return nil, nil, err
-				}
-				stateRules[direction] = append(stateRules[direction], rulesGroup)
-			}
-		}
-		// There are rule in tfstate not present in api
-		if len(apiRules[direction]) != len(stateRules[direction]) {
-			// Truncate stateRules with apiRules length
-			stateRules[direction] = stateRules[direction][0:len(apiRules[direction])]
+		stateRules[direction], err = securityGroupStateRulesFromAPI(stateRules[direction], apiRules[direction])
+		if err != nil {
+			return nil, nil, err
 		}
 	}
 
@@ -289,11 +275,18 @@ func resourceScalewayInstanceSecurityGroupUpdate(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
-	if !d.Get("external_rules").(bool) {
+	if d.Get("external_rules").(bool) {
+		oldInbound, _ := d.GetChange("inbound_rule")
+		oldOutbound, _ := d.GetChange("outbound_rule")
+		err = updateSecurityGroupOwnedRules(ctx, instanceAPI, zone, ID, map[instance.SecurityGroupRuleDirection][]interface{}{
+			instance.SecurityGroupRuleDirectionInbound:  oldInbound.([]interface{}),
+			instance.SecurityGroupRuleDirectionOutbound: oldOutbound.([]interface{}),
+		}, securityGroupStateRules(d))
+	} else {
 		err = updateSecurityGroupeRules(ctx, d, zone, ID, instanceAPI)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceScalewayInstanceSecurityGroupRead(ctx, d, meta)
@@ -309,12 +302,12 @@ func updateSecurityGroupeRules(ctx context.Context, d *schema.ResourceData, zone
 	setGroupRules := []*instance.SetSecurityGroupRulesRequestRule{}
 	for direction := range stateRules {
 		// Loop for all state rules in this direction
-		for _, rawStateRule := range stateRules[direction] {
-			stateRule, err := securityGroupRuleExpand(rawStateRule)
-			if err != nil {
-				return err
-			}
+		expandedRules, err := securityGroupRulesExpand(stateRules[direction])
+		if err != nil {
+			return err
+		}
 
+		for _, stateRule := range expandedRules {
 			// This happens when there is more rule in state than in the api. We create more rule in API.
 			setGroupRules = append(setGroupRules, &instance.SetSecurityGroupRulesRequestRule{
 				Zone:         zone,
@@ -340,6 +333,255 @@ func updateSecurityGroupeRules(ctx context.Context, d *schema.ResourceData, zone
 	return nil
 }
 
+// securityGroupStateRules returns the inbound and outbound rules set in the state.
+func securityGroupStateRules(d *schema.ResourceData) map[instance.SecurityGroupRuleDirection][]interface{} {
+	return map[instance.SecurityGroupRuleDirection][]interface{}{
+		instance.SecurityGroupRuleDirectionInbound:  d.Get("inbound_rule").([]interface{}),
+		instance.SecurityGroupRuleDirectionOutbound: d.Get("outbound_rule").([]interface{}),
+	}
+}
+
+// getSecurityGroupOwnedRules returns the state rules which still exist in the api, ignoring rules managed elsewhere.
+func getSecurityGroupOwnedRules(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, securityGroupID string, d *schema.ResourceData) ([]interface{}, []interface{}, error) {
+	stateRules := securityGroupStateRules(d)
+	if len(stateRules[instance.SecurityGroupRuleDirectionInbound]) == 0 && len(stateRules[instance.SecurityGroupRuleDirectionOutbound]) == 0 {
+		return []interface{}{}, []interface{}{}, nil
+	}
+
+	apiRules, err := listSecurityGroupEditableRules(ctx, instanceAPI, zone, securityGroupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownedRules := map[instance.SecurityGroupRuleDirection][]interface{}{}
+	for direction := range stateRules {
+		ownedRules[direction], err = securityGroupOwnedRules(stateRules[direction], apiRules[direction])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return ownedRules[instance.SecurityGroupRuleDirectionInbound], ownedRules[instance.SecurityGroupRuleDirectionOutbound], nil
+}
+
+// updateSecurityGroupOwnedRules creates and deletes rules one by one so that rules managed elsewhere are left untouched.
+func updateSecurityGroupOwnedRules(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, securityGroupID string, oldRules, newRules map[instance.SecurityGroupRuleDirection][]interface{}) error {
+	if len(oldRules[instance.SecurityGroupRuleDirectionInbound])+len(oldRules[instance.SecurityGroupRuleDirectionOutbound])+
+		len(newRules[instance.SecurityGroupRuleDirectionInbound])+len(newRules[instance.SecurityGroupRuleDirectionOutbound]) == 0 {
+		return nil
+	}
+
+	apiRules, err := listSecurityGroupEditableRules(ctx, instanceAPI, zone, securityGroupID)
+	if err != nil {
+		return err
+	}
+
+	for _, direction := range []instance.SecurityGroupRuleDirection{instance.SecurityGroupRuleDirectionInbound, instance.SecurityGroupRuleDirectionOutbound} {
+		oldDirectionRules, err := securityGroupRulesExpand(oldRules[direction])
+		if err != nil {
+			return err
+		}
+		newDirectionRules, err := securityGroupRulesExpand(newRules[direction])
+		if err != nil {
+			return err
+		}
+
+		toDelete, toCreate, err := securityGroupRulesDiff(apiRules[direction], oldDirectionRules, newDirectionRules)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range toDelete {
+			err = instanceAPI.DeleteSecurityGroupRule(&instance.DeleteSecurityGroupRuleRequest{
+				Zone:                zone,
+				SecurityGroupID:     securityGroupID,
+				SecurityGroupRuleID: rule.ID,
+			}, scw.WithContext(ctx))
+			if err != nil && !is404Error(err) {
+				return err
+			}
+		}
+
+		for _, rule := range toCreate {
+			_, err = instanceAPI.CreateSecurityGroupRule(&instance.CreateSecurityGroupRuleRequest{
+				Zone:            zone,
+				SecurityGroupID: securityGroupID,
+				Protocol:        rule.Protocol,
+				Direction:       direction,
+				Action:          rule.Action,
+				IPRange:         rule.IPRange,
+				DestPortFrom:    rule.DestPortFrom,
+				DestPortTo:      rule.DestPortTo,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// listSecurityGroupEditableRules returns the editable rules of a security group by direction.
+func listSecurityGroupEditableRules(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, securityGroupID string) (map[instance.SecurityGroupRuleDirection][]*instance.SecurityGroupRule, error) {
+	resRules, err := instanceAPI.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(resRules.Rules, func(i, j int) bool {
+		return resRules.Rules[i].Position < resRules.Rules[j].Position
+	})
+
+	apiRules := map[instance.SecurityGroupRuleDirection][]*instance.SecurityGroupRule{}
+	for _, apiRule := range resRules.Rules {
+		if !apiRule.Editable {
+			continue
+		}
+		apiRules[apiRule.Direction] = append(apiRules[apiRule.Direction], apiRule)
+	}
+
+	return apiRules, nil
+}
+
+// securityGroupRulesExpand transforms a list of state rules to api ones.
+func securityGroupRulesExpand(rawRules []interface{}) ([]*instance.SecurityGroupRule, error) {
+	rules := make([]*instance.SecurityGroupRule, 0, len(rawRules))
+	for _, rawRule := range rawRules {
+		expandedRules, err := securityGroupRuleExpandAll(rawRule)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, expandedRules...)
+	}
+	return rules, nil
+}
+
+// securityGroupStateRulesFromAPI keeps the state rules matching their api rules, in order, and flattens the others.
+// A state rule using ip_ranges or ports matches as many consecutive api rules as it expands to.
+func securityGroupStateRulesFromAPI(stateRules []interface{}, apiRules []*instance.SecurityGroupRule) ([]interface{}, error) {
+	rules := []interface{}{}
+	apiIndex := 0
+	for _, rawStateRule := range stateRules {
+		if apiIndex >= len(apiRules) {
+			// There are rule in tfstate not present in api
+			break
+		}
+		expandedRules, err := securityGroupRuleExpandAll(rawStateRule)
+		if err != nil {
+			return nil, err
+		}
+		if securityGroupRulesEqualAt(expandedRules, apiRules, apiIndex) {
+			rules = append(rules, rawStateRule)
+			apiIndex += len(expandedRules)
+			continue
+		}
+		rawRule, err := securityGroupRuleFlatten(apiRules[apiIndex])
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rawRule)
+		apiIndex++
+	}
+
+	for ; apiIndex < len(apiRules); apiIndex++ {
+		rawRule, err := securityGroupRuleFlatten(apiRules[apiIndex])
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rawRule)
+	}
+
+	return rules, nil
+}
+
+// securityGroupRulesEqualAt returns true if rules are equal to the api rules starting at index.
+func securityGroupRulesEqualAt(rules, apiRules []*instance.SecurityGroupRule, index int) bool {
+	if index+len(rules) > len(apiRules) {
+		return false
+	}
+	for i, rule := range rules {
+		if ok, _ := securityGroupRuleEquals(rule, apiRules[index+i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// securityGroupOwnedRules keeps the state rules matching an api rule. Each api rule can only match a single state rule.
+func securityGroupOwnedRules(stateRules []interface{}, apiRules []*instance.SecurityGroupRule) ([]interface{}, error) {
+	matched := make([]bool, len(apiRules))
+	ownedRules := []interface{}{}
+	for _, rawRule := range stateRules {
+		rules, err := securityGroupRuleExpandAll(rawRule)
+		if err != nil {
+			return nil, err
+		}
+		owned := true
+		for _, rule := range rules {
+			index, err := securityGroupRuleMatch(rule, apiRules, matched)
+			if err != nil {
+				return nil, err
+			}
+			owned = owned && index >= 0
+		}
+		if owned {
+			ownedRules = append(ownedRules, rawRule)
+		}
+	}
+	return ownedRules, nil
+}
+
+// securityGroupRulesDiff returns the api rules to delete and the rules to create to go from oldRules to newRules.
+// Api rules which do not match any old or new rule are considered managed elsewhere and are left untouched.
+func securityGroupRulesDiff(apiRules, oldRules, newRules []*instance.SecurityGroupRule) ([]*instance.SecurityGroupRule, []*instance.SecurityGroupRule, error) {
+	matched := make([]bool, len(apiRules))
+
+	toCreate := []*instance.SecurityGroupRule(nil)
+	for _, rule := range newRules {
+		index, err := securityGroupRuleMatch(rule, apiRules, matched)
+		if err != nil {
+			return nil, nil, err
+		}
+		if index < 0 {
+			toCreate = append(toCreate, rule)
+		}
+	}
+
+	toDelete := []*instance.SecurityGroupRule(nil)
+	for _, rule := range oldRules {
+		index, err := securityGroupRuleMatch(rule, apiRules, matched)
+		if err != nil {
+			return nil, nil, err
+		}
+		if index >= 0 {
+			toDelete = append(toDelete, apiRules[index])
+		}
+	}
+
+	return toDelete, toCreate, nil
+}
+
+// securityGroupRuleMatch returns the index of the first api rule not matched yet that equals rule, or -1.
+func securityGroupRuleMatch(rule *instance.SecurityGroupRule, apiRules []*instance.SecurityGroupRule, matched []bool) (int, error) {
+	for index, apiRule := range apiRules {
+		if matched[index] {
+			continue
+		}
+		equal, err := securityGroupRuleEquals(rule, apiRule)
+		if err != nil {
+			return -1, err
+		}
+		if equal {
+			matched[index] = true
+			return index, nil
+		}
+	}
+	return -1, nil
+}
+
 func resourceScalewayInstanceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, _, err := instanceAPIWithZone(d, meta)
 	if err != nil {
@@ -410,6 +652,24 @@ func securityGroupRuleSchema() *schema.Resource {
 				ValidateFunc: validation.IsCIDRNetwork(0, 128),
 				Description:  "Ip range for this rule (e.g: 192.168.1.0/24). Only one of ip or ip_range should be provided",
 			},
+			"ip_ranges": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDRNetwork(0, 128),
+				},
+				Description: "Ip ranges for this rule, one api rule is created per ip range and port. Can't be used with ip or ip_range",
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, math.MaxUint16),
+				},
+				Description: "Network ports for this rule, one api rule is created per ip range and port. Can't be used with port or port_range",
+			},
 		},
 	}
 }
@@ -462,6 +722,174 @@ func securityGroupRuleExpand(i interface{}) (*instance.SecurityGroupRule, error)
 	return rule, nil
 }
 
+// securityGroupRuleConflicts returns an error if a state rule sets ip_ranges with ip or ip_range, or ports with port or port_range.
+func securityGroupRuleConflicts(rawRule map[string]interface{}) error {
+	ipRanges, _ := rawRule["ip_ranges"].([]interface{})
+	ports, _ := rawRule["ports"].([]interface{})
+	if len(ipRanges) > 0 && (rawRule["ip"].(string) != "" || rawRule["ip_range"].(string) != "") {
+		return fmt.Errorf("only one of ip, ip_range and ip_ranges should be provided")
+	}
+	if len(ports) > 0 && (rawRule["port"].(int) != 0 || rawRule["port_range"].(string) != "") {
+		return fmt.Errorf("only one of port, port_range and ports should be provided")
+	}
+	return nil
+}
+
+// securityGroupRulesCount returns the number of api rules the state rules expand to.
+func securityGroupRulesCount(rawRules []interface{}) int {
+	count := 0
+	for _, i := range rawRules {
+		rawRule, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules := 1
+		if ipRanges, _ := rawRule["ip_ranges"].([]interface{}); len(ipRanges) > 0 {
+			rules *= len(ipRanges)
+		}
+		if ports, _ := rawRule["ports"].([]interface{}); len(ports) > 0 {
+			rules *= len(ports)
+		}
+		count += rules
+	}
+	return count
+}
+
+// customizeDiffSecurityGroupRules checks that the inbound and outbound rules do not mix single and list ips or ports,
+// and that they do not expand to more rules than the api accepts.
+// When external_rules is set, the rules managed elsewhere are fetched from the api and counted too.
+// Going over the limit is an error rather than a warning: warnings are not reported at plan time,
+// and the api would reject the rules during the apply, after part of them were created.
+func customizeDiffSecurityGroupRules(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("inbound_rule", "outbound_rule") {
+		return nil
+	}
+
+	for _, key := range []string{"inbound_rule", "outbound_rule"} {
+		for _, rawRule := range diff.Get(key).([]interface{}) {
+			if err := securityGroupRuleConflicts(rawRule.(map[string]interface{})); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+
+	count := securityGroupRulesCount(diff.Get("inbound_rule").([]interface{})) + securityGroupRulesCount(diff.Get("outbound_rule").([]interface{}))
+
+	if diff.Get("external_rules").(bool) && diff.Id() != "" {
+		externalCount, err := securityGroupExternalRulesCount(ctx, diff, meta)
+		if err != nil {
+			return err
+		}
+		count += externalCount
+	}
+
+	if count > maxInstanceSecurityGroupRules {
+		return fmt.Errorf("inbound_rule, outbound_rule and external rules add up to %d rules, a security group accepts at most %d rules: reduce the number of ip_ranges or ports", count, maxInstanceSecurityGroupRules)
+	}
+
+	return nil
+}
+
+// securityGroupExternalRulesCount returns the number of editable api rules which are not managed by the resource.
+func securityGroupExternalRulesCount(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (int, error) {
+	instanceAPI, zone, securityGroupID, err := instanceAPIWithZoneAndID(meta, diff.Id())
+	if err != nil {
+		return 0, err
+	}
+
+	apiRules, err := listSecurityGroupEditableRules(ctx, instanceAPI, zone, securityGroupID)
+	if err != nil {
+		return 0, err
+	}
+
+	oldInboundRules, _ := diff.GetChange("inbound_rule")
+	oldOutboundRules, _ := diff.GetChange("outbound_rule")
+	oldRules := map[instance.SecurityGroupRuleDirection][]interface{}{
+		instance.SecurityGroupRuleDirectionInbound:  oldInboundRules.([]interface{}),
+		instance.SecurityGroupRuleDirectionOutbound: oldOutboundRules.([]interface{}),
+	}
+
+	count := 0
+	for direction, rawRules := range oldRules {
+		rules, err := securityGroupRulesExpand(rawRules)
+		if err != nil {
+			return 0, err
+		}
+		external, err := securityGroupExternalRules(apiRules[direction], rules)
+		if err != nil {
+			return 0, err
+		}
+		count += len(external)
+	}
+
+	return count, nil
+}
+
+// securityGroupExternalRules returns the api rules which do not match any of the rules managed by the resource.
+func securityGroupExternalRules(apiRules, ownedRules []*instance.SecurityGroupRule) ([]*instance.SecurityGroupRule, error) {
+	matched := make([]bool, len(apiRules))
+	for _, rule := range ownedRules {
+		if _, err := securityGroupRuleMatch(rule, apiRules, matched); err != nil {
+			return nil, err
+		}
+	}
+
+	external := []*instance.SecurityGroupRule(nil)
+	for index, apiRule := range apiRules {
+		if !matched[index] {
+			external = append(external, apiRule)
+		}
+	}
+	return external, nil
+}
+
+// securityGroupRuleExpandAll transforms a state rule to api ones, creating a rule for each ip range and port
+// when ip_ranges or ports are set.
+func securityGroupRuleExpandAll(i interface{}) ([]*instance.SecurityGroupRule, error) {
+	rawRule := i.(map[string]interface{})
+
+	if err := securityGroupRuleConflicts(rawRule); err != nil {
+		return nil, err
+	}
+
+	ipRanges, _ := rawRule["ip_ranges"].([]interface{})
+	ports, _ := rawRule["ports"].([]interface{})
+	if len(ipRanges) == 0 && len(ports) == 0 {
+		rule, err := securityGroupRuleExpand(rawRule)
+		if err != nil {
+			return nil, err
+		}
+		return []*instance.SecurityGroupRule{rule}, nil
+	}
+
+	if len(ipRanges) == 0 {
+		ipRanges = []interface{}{rawRule["ip_range"]}
+	}
+	if len(ports) == 0 {
+		ports = []interface{}{rawRule["port"]}
+	}
+
+	rules := make([]*instance.SecurityGroupRule, 0, len(ipRanges)*len(ports))
+	for _, ipRange := range ipRanges {
+		for _, port := range ports {
+			expandedRawRule := make(map[string]interface{}, len(rawRule))
+			for key, value := range rawRule {
+				expandedRawRule[key] = value
+			}
+			expandedRawRule["ip_range"] = ipRange
+			expandedRawRule["port"] = port
+
+			rule, err := securityGroupRuleExpand(expandedRawRule)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
+
 // securityGroupRuleFlatten transform an api rule to a state one.
 func securityGroupRuleFlatten(rule *instance.SecurityGroupRule) (map[string]interface{}, error) {
 	portFrom, portTo := uint32(0), uint32(0)
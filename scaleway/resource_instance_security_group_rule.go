@@ -0,0 +1,218 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceSecurityGroupRuleCreate,
+		ReadContext:   resourceScalewayInstanceSecurityGroupRuleRead,
+		DeleteContext: resourceScalewayInstanceSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultInstanceSecurityGroupRuleTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDWithLocality(),
+				Description:  "The security group the rule belongs to",
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.SecurityGroupRuleDirectionInbound.String(),
+					instance.SecurityGroupRuleDirectionOutbound.String(),
+				}, false),
+				Description: "Direction of the traffic matched by the rule (inbound or outbound)",
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.SecurityGroupRuleActionAccept.String(),
+					instance.SecurityGroupRuleActionDrop.String(),
+				}, false),
+				Description: "Action when rule match request (drop or accept)",
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  instance.SecurityGroupRuleProtocolTCP.String(),
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.SecurityGroupRuleProtocolICMP.String(),
+					instance.SecurityGroupRuleProtocolTCP.String(),
+					instance.SecurityGroupRuleProtocolUDP.String(),
+					instance.SecurityGroupRuleProtocolANY.String(),
+				}, false),
+				Description: "Protocol for this rule (TCP, UDP, ICMP or ANY)",
+			},
+			"port": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"port_range"},
+				Description:   "Network port for this rule",
+			},
+			"port_range": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"port"},
+				Description:   "Port range for this rule (e.g: 1-1024, 22-22)",
+			},
+			"ip_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "0.0.0.0/0",
+				ValidateFunc: validation.IsCIDRNetwork(0, 128),
+				Description:  "Ip range for this rule (e.g: 192.168.1.0/24)",
+			},
+			"position": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Position of the rule in the security group",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone of the security group rule",
+			},
+		},
+	}
+}
+
+func resourceScalewayInstanceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, securityGroupID, err := instanceAPIWithZoneAndID(meta, d.Get("security_group_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := securityGroupRuleExpand(securityGroupRuleResourceRaw(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.CreateSecurityGroupRule(&instance.CreateSecurityGroupRuleRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+		Protocol:        rule.Protocol,
+		Direction:       instance.SecurityGroupRuleDirection(d.Get("direction").(string)),
+		Action:          rule.Action,
+		IPRange:         rule.IPRange,
+		DestPortFrom:    rule.DestPortFrom,
+		DestPortTo:      rule.DestPortTo,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedNestedIDString(zone, securityGroupID, res.Rule.ID))
+
+	return resourceScalewayInstanceSecurityGroupRuleRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI := instance.NewAPI(meta.(*Meta).scwClient)
+	zone, ruleID, securityGroupID, err := parseZonedNestedID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := instanceAPI.GetSecurityGroupRule(&instance.GetSecurityGroupRuleRequest{
+		Zone:                zone,
+		SecurityGroupID:     securityGroupID,
+		SecurityGroupRuleID: ruleID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	rule := res.Rule
+
+	_ = d.Set("security_group_id", newZonedIDString(zone, securityGroupID))
+	_ = d.Set("direction", rule.Direction.String())
+	_ = d.Set("position", int(rule.Position))
+	_ = d.Set("zone", zone)
+
+	// Keep the user input (port or port_range) if it matches the api rule.
+	if stateRule, err := securityGroupRuleExpand(securityGroupRuleResourceRaw(d)); err == nil {
+		if equal, _ := securityGroupRuleEquals(stateRule, rule); equal {
+			return nil
+		}
+	}
+
+	ipRange, err := flattenIPNet(rule.IPRange)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("action", rule.Action.String())
+	_ = d.Set("protocol", rule.Protocol.String())
+	_ = d.Set("ip_range", ipRange)
+
+	// Prefer port for single port rules, as it is the most common way to write them.
+	port, portRange := 0, ""
+	switch {
+	case rule.DestPortFrom == nil:
+	case rule.DestPortTo == nil || *rule.DestPortTo == *rule.DestPortFrom:
+		port = int(*rule.DestPortFrom)
+	default:
+		portRange = fmt.Sprintf("%d-%d", *rule.DestPortFrom, *rule.DestPortTo)
+	}
+	_ = d.Set("port", port)
+	_ = d.Set("port_range", portRange)
+
+	return nil
+}
+
+func resourceScalewayInstanceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI := instance.NewAPI(meta.(*Meta).scwClient)
+	zone, ruleID, securityGroupID, err := parseZonedNestedID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = instanceAPI.DeleteSecurityGroupRule(&instance.DeleteSecurityGroupRuleRequest{
+		Zone:                zone,
+		SecurityGroupID:     securityGroupID,
+		SecurityGroupRuleID: ruleID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// securityGroupRuleResourceRaw returns the rule described by a scaleway_instance_security_group_rule
+// in the same shape as a security group inbound_rule/outbound_rule block.
+func securityGroupRuleResourceRaw(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"action":     d.Get("action"),
+		"protocol":   d.Get("protocol"),
+		"port":       d.Get("port"),
+		"port_range": d.Get("port_range"),
+		"ip":         "",
+		"ip_range":   d.Get("ip_range"),
+	}
+}
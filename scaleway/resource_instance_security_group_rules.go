@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 )
 
 func resourceScalewayInstanceSecurityGroupRules() *schema.Resource {
@@ -19,6 +20,7 @@ func resourceScalewayInstanceSecurityGroupRules() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Default: schema.DefaultTimeout(defaultInstanceSecurityGroupRuleTimeout),
 		},
+		CustomizeDiff: customizeDiffSecurityGroupRules,
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -40,6 +42,12 @@ func resourceScalewayInstanceSecurityGroupRules() *schema.Resource {
 				Description: "Outbound rules for this set of security group rules",
 				Elem:        securityGroupRuleSchema(),
 			},
+			"external_rules": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Tolerate rules managed outside of this resource, only the rules set in inbound_rule and outbound_rule are managed",
+			},
 		},
 	}
 }
@@ -61,7 +69,11 @@ func resourceScalewayInstanceSecurityGroupRulesRead(ctx context.Context, d *sche
 
 	_ = d.Set("security_group_id", securityGroupZonedID)
 
-	inboundRules, outboundRules, err := getSecurityGroupRules(ctx, instanceAPI, zone, securityGroupID, d)
+	getRules := getSecurityGroupRules
+	if d.Get("external_rules").(bool) {
+		getRules = getSecurityGroupOwnedRules
+	}
+	inboundRules, outboundRules, err := getRules(ctx, instanceAPI, zone, securityGroupID, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -79,7 +91,16 @@ func resourceScalewayInstanceSecurityGroupRulesUpdate(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	err = updateSecurityGroupeRules(ctx, d, zone, securityGroupID, instanceAPI)
+	if d.Get("external_rules").(bool) {
+		oldInbound, _ := d.GetChange("inbound_rule")
+		oldOutbound, _ := d.GetChange("outbound_rule")
+		err = updateSecurityGroupOwnedRules(ctx, instanceAPI, zone, securityGroupID, map[instance.SecurityGroupRuleDirection][]interface{}{
+			instance.SecurityGroupRuleDirectionInbound:  oldInbound.([]interface{}),
+			instance.SecurityGroupRuleDirectionOutbound: oldOutbound.([]interface{}),
+		}, securityGroupStateRules(d))
+	} else {
+		err = updateSecurityGroupeRules(ctx, d, zone, securityGroupID, instanceAPI)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -94,6 +115,14 @@ func resourceScalewayInstanceSecurityGroupRulesDelete(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
+	if d.Get("external_rules").(bool) {
+		err = updateSecurityGroupOwnedRules(ctx, instanceAPI, zone, securityGroupID, securityGroupStateRules(d), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
 	_ = d.Set("inbound_rule", nil)
 	_ = d.Set("outbound_rule", nil)
 
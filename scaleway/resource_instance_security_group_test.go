@@ -649,3 +649,142 @@ func TestAccScalewayInstanceSecurityGroup_EnableDefaultSecurity(t *testing.T) {
 		},
 	})
 }
+
+func TestSecurityGroupRulesDiff(t *testing.T) {
+	rule := func(id string, port int) *instance.SecurityGroupRule {
+		ipRange, err := expandIPNet("0.0.0.0/0")
+		assert.NoError(t, err)
+		return &instance.SecurityGroupRule{
+			ID:           id,
+			Protocol:     instance.SecurityGroupRuleProtocolTCP,
+			Action:       instance.SecurityGroupRuleActionAccept,
+			IPRange:      ipRange,
+			DestPortFrom: scw.Uint32Ptr(uint32(port)),
+		}
+	}
+
+	// 22 is managed elsewhere, 80 is kept, 443 is removed and 8080 is added.
+	apiRules := []*instance.SecurityGroupRule{rule("a", 22), rule("b", 80), rule("c", 443)}
+	oldRules := []*instance.SecurityGroupRule{rule("", 80), rule("", 443)}
+	newRules := []*instance.SecurityGroupRule{rule("", 80), rule("", 8080)}
+
+	toDelete, toCreate, err := securityGroupRulesDiff(apiRules, oldRules, newRules)
+	assert.NoError(t, err)
+	assert.Len(t, toDelete, 1)
+	assert.Equal(t, "c", toDelete[0].ID)
+	assert.Len(t, toCreate, 1)
+	assert.Equal(t, uint32(8080), *toCreate[0].DestPortFrom)
+
+	// a duplicated rule only matches a single api rule
+	toDelete, toCreate, err = securityGroupRulesDiff([]*instance.SecurityGroupRule{rule("a", 22)}, nil, []*instance.SecurityGroupRule{rule("", 22), rule("", 22)})
+	assert.NoError(t, err)
+	assert.Len(t, toDelete, 0)
+	assert.Len(t, toCreate, 1)
+}
+
+func TestSecurityGroupOwnedRules(t *testing.T) {
+	ipRange, err := expandIPNet("0.0.0.0/0")
+	assert.NoError(t, err)
+	apiRules := []*instance.SecurityGroupRule{
+		{ID: "a", Protocol: instance.SecurityGroupRuleProtocolTCP, Action: instance.SecurityGroupRuleActionAccept, IPRange: ipRange, DestPortFrom: scw.Uint32Ptr(22)},
+		{ID: "b", Protocol: instance.SecurityGroupRuleProtocolTCP, Action: instance.SecurityGroupRuleActionAccept, IPRange: ipRange, DestPortFrom: scw.Uint32Ptr(80)},
+	}
+	stateRule := func(port int) map[string]interface{} {
+		return map[string]interface{}{
+			"action":     "accept",
+			"protocol":   "TCP",
+			"port":       port,
+			"port_range": "",
+			"ip":         "",
+			"ip_range":   "0.0.0.0/0",
+		}
+	}
+
+	ownedRules, err := securityGroupOwnedRules([]interface{}{stateRule(80), stateRule(443)}, apiRules)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{stateRule(80)}, ownedRules)
+}
+
+func TestSecurityGroupExternalRules(t *testing.T) {
+	rawRule := func(port int) map[string]interface{} {
+		return map[string]interface{}{
+			"action":     "accept",
+			"protocol":   "TCP",
+			"port":       port,
+			"port_range": "",
+			"ip":         "",
+			"ip_range":   "0.0.0.0/0",
+		}
+	}
+
+	ownedRules, err := securityGroupRulesExpand([]interface{}{rawRule(22), rawRule(80)})
+	assert.NoError(t, err)
+	apiRules, err := securityGroupRulesExpand([]interface{}{rawRule(22), rawRule(443), rawRule(80), rawRule(80)})
+	assert.NoError(t, err)
+
+	externalRules, err := securityGroupExternalRules(apiRules, ownedRules)
+	assert.NoError(t, err)
+	assert.Equal(t, []*instance.SecurityGroupRule{apiRules[1], apiRules[3]}, externalRules)
+}
+
+func TestSecurityGroupRuleExpandAll(t *testing.T) {
+	rawRule := map[string]interface{}{
+		"action":     "accept",
+		"protocol":   "TCP",
+		"port":       0,
+		"port_range": "",
+		"ip":         "",
+		"ip_range":   "",
+		"ip_ranges":  []interface{}{"10.0.0.0/8", "192.168.0.0/16"},
+		"ports":      []interface{}{22, 443, 8443},
+	}
+
+	rules, err := securityGroupRuleExpandAll(rawRule)
+	assert.NoError(t, err)
+	assert.Len(t, rules, 6)
+	assert.Equal(t, "10.0.0.0/8", rules[0].IPRange.String())
+	assert.Equal(t, uint32(22), *rules[0].DestPortFrom)
+	assert.Equal(t, "192.168.0.0/16", rules[5].IPRange.String())
+	assert.Equal(t, uint32(8443), *rules[5].DestPortFrom)
+	assert.Equal(t, 6, securityGroupRulesCount([]interface{}{rawRule}))
+
+	// matching api rules keep the state rule as is
+	stateRules, err := securityGroupStateRulesFromAPI([]interface{}{rawRule}, rules)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{rawRule}, stateRules)
+
+	// a missing api rule breaks the match
+	stateRules, err = securityGroupStateRulesFromAPI([]interface{}{rawRule}, rules[1:])
+	assert.NoError(t, err)
+	assert.Len(t, stateRules, 5)
+
+	rawRule["port"] = 80
+	_, err = securityGroupRuleExpandAll(rawRule)
+	assert.Error(t, err)
+}
+
+func TestSecurityGroupRuleConflicts(t *testing.T) {
+	rawRule := func(overrides map[string]interface{}) map[string]interface{} {
+		rule := map[string]interface{}{
+			"port":       0,
+			"port_range": "",
+			"ip":         "",
+			"ip_range":   "",
+			"ip_ranges":  []interface{}{},
+			"ports":      []interface{}{},
+		}
+		for key, value := range overrides {
+			rule[key] = value
+		}
+		return rule
+	}
+
+	assert.NoError(t, securityGroupRuleConflicts(rawRule(nil)))
+	assert.NoError(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ip_range": "10.0.0.0/8", "port": 22})))
+	assert.NoError(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ip_ranges": []interface{}{"10.0.0.0/8"}, "port_range": "22-23"})))
+	assert.NoError(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ip": "10.0.0.1", "ports": []interface{}{22}})))
+	assert.Error(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ip_ranges": []interface{}{"10.0.0.0/8"}, "ip": "10.0.0.1"})))
+	assert.Error(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ip_ranges": []interface{}{"10.0.0.0/8"}, "ip_range": "10.0.0.0/8"})))
+	assert.Error(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ports": []interface{}{22}, "port": 22})))
+	assert.Error(t, securityGroupRuleConflicts(rawRule(map[string]interface{}{"ports": []interface{}{22}, "port_range": "22-23"})))
+}
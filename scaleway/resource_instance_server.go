@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -226,6 +227,12 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Description:  "The cloud init script associated with this server",
 				ValidateFunc: validation.StringLenBetween(0, 127998),
 			},
+			"wait_for_cloud_init": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for cloud-init to report its status in the cloud-init-status user data key when the server is created",
+			},
 			"user_data": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -493,6 +500,18 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	if d.Get("wait_for_cloud_init").(bool) && targetState == instance.ServerStateRunning {
+		err = waitForInstanceServerCloudInit(ctx, instanceAPI, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("cloud-init did not complete on server %s", res.Server.ID),
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("cloud_init"),
+			}}
+		}
+	}
+
 	return resourceScalewayInstanceServerRead(ctx, d, meta)
 }
 
@@ -622,6 +641,10 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 
 		userData := make(map[string]interface{})
 		for key, value := range allUserData.UserData {
+			// The cloud-init status is written by the server itself, it is not managed by terraform.
+			if key == instanceServerCloudInitStatusKey && d.Get("wait_for_cloud_init").(bool) {
+				continue
+			}
 			userDataValue, err := io.ReadAll(value)
 			if err != nil {
 				return diag.FromErr(err)
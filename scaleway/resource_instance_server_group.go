@@ -0,0 +1,626 @@
+package scaleway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	scwvalidation "github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+const (
+	// instanceServerGroupTag is the tag prefix identifying the servers of a group.
+	instanceServerGroupTag = "scaleway_instance_server_group="
+	// instanceServerGroupTemplateTag is the tag prefix holding the hash of the template a server was created from.
+	instanceServerGroupTemplateTag = "scaleway_instance_server_group_template="
+)
+
+func resourceScalewayInstanceServerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceServerGroupCreate,
+		ReadContext:   resourceScalewayInstanceServerGroupRead,
+		UpdateContext: resourceScalewayInstanceServerGroupUpdate,
+		DeleteContext: resourceScalewayInstanceServerGroupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceServerGroupTimeout),
+			Update:  schema.DefaultTimeout(defaultInstanceServerGroupTimeout),
+			Delete:  schema.DefaultTimeout(defaultInstanceServerGroupTimeout),
+			Default: schema.DefaultTimeout(defaultInstanceServerGroupTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the server group, used as a prefix for the name of its servers",
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of running servers of the group",
+			},
+			"max_surge": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of servers that can be created above size during a rolling update",
+			},
+			"max_unavailable": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of servers that can be missing below size during a rolling update",
+			},
+			"template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The template of the servers of the group, changing it replaces the servers with a rolling update",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The instance type of the servers",
+						},
+						"image": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The UUID or the label of the base image used by the servers",
+						},
+						"security_group_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validationUUIDorUUIDWithLocality(),
+							Description:  "The security group the servers are attached to",
+						},
+						"placement_group_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validationUUIDorUUIDWithLocality(),
+							Description:  "The placement group the servers are attached to",
+						},
+						"enable_ipv6": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if IPv6 is enabled for the servers",
+						},
+						"enable_dynamic_ip": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable dynamic IP on the servers",
+						},
+						"root_volume_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								instance.VolumeVolumeTypeLSSD.String(),
+								instance.VolumeVolumeTypeBSSD.String(),
+							}, false),
+							Description: "Volume type of the root volume of the servers",
+						},
+						"root_volume_size_in_gb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Size of the root volume of the servers in gigabytes",
+						},
+						"additional_volume": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The additional volumes created for each server",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"size_in_gb": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "Size of the volume in gigabytes",
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  instance.VolumeVolumeTypeBSSD.String(),
+										ValidateFunc: validation.StringInSlice([]string{
+											instance.VolumeVolumeTypeLSSD.String(),
+											instance.VolumeVolumeTypeBSSD.String(),
+										}, false),
+										Description: "Type of the volume",
+									},
+								},
+							},
+						},
+						"tags": {
+							Type: schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional:    true,
+							Description: "The tags associated with the servers",
+						},
+						"cloud_init": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 127998),
+							Description:  "The cloud init script associated with the servers",
+						},
+						"user_data": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The user data associated with the servers",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The servers of the group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"up_to_date": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the server was created from the current template",
+						},
+					},
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+		CustomizeDiff: resourceScalewayInstanceServerGroupCustomizeDiff,
+	}
+}
+
+// resourceScalewayInstanceServerGroupCustomizeDiff checks that a rolling update is able to replace servers.
+func resourceScalewayInstanceServerGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("max_surge").(int) == 0 && diff.Get("max_unavailable").(int) == 0 {
+		return fmt.Errorf("max_surge and max_unavailable can't both be 0, servers could not be replaced")
+	}
+	return nil
+}
+
+func resourceScalewayInstanceServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", expandOrGenerateString(d.Get("name"), "srv-group"))
+	d.SetId(newZonedIDString(zone, resource.PrefixedUniqueId("srv-group-")))
+
+	err = reconcileInstanceServerGroup(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayInstanceServerGroupRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, groupID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	servers, err := listInstanceServerGroupServers(ctx, instanceAPI, zone, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateHash, err := instanceServerGroupTemplateHash(d.Get("template"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	upToDateCount := 0
+	rawServers := []map[string]interface{}(nil)
+	for _, server := range servers {
+		upToDate := instanceServerGroupServerUpToDate(server, templateHash)
+		if upToDate {
+			upToDateCount++
+		}
+
+		rawServer := map[string]interface{}{
+			"id":         newZonedIDString(zone, server.ID),
+			"name":       server.Name,
+			"state":      server.State.String(),
+			"up_to_date": upToDate,
+		}
+		if server.PublicIP != nil {
+			rawServer["public_ip"] = server.PublicIP.Address.String()
+		}
+		if server.PrivateIP != nil {
+			rawServer["private_ip"] = *server.PrivateIP
+		}
+		rawServers = append(rawServers, rawServer)
+	}
+
+	// Size only counts healthy servers so that missing or outdated servers are replaced on next apply.
+	_ = d.Set("size", upToDateCount)
+	_ = d.Set("servers", rawServers)
+	_ = d.Set("zone", zone)
+	if len(servers) > 0 {
+		_ = d.Set("organization_id", servers[0].Organization)
+		_ = d.Set("project_id", servers[0].Project)
+	}
+
+	return nil
+}
+
+func resourceScalewayInstanceServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("size", "template") {
+		err := reconcileInstanceServerGroup(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayInstanceServerGroupRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, groupID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	servers, err := listInstanceServerGroupServers(ctx, instanceAPI, zone, groupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, server := range servers {
+		err = deleteInstanceServerGroupServer(ctx, instanceAPI, server, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileInstanceServerGroup creates and deletes servers until the group has size servers created from the current template.
+func reconcileInstanceServerGroup(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	instanceAPI, zone, groupID, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return err
+	}
+
+	templateHash, err := instanceServerGroupTemplateHash(d.Get("template"))
+	if err != nil {
+		return err
+	}
+
+	createReq, userData, err := expandInstanceServerGroupTemplate(ctx, instanceAPI, meta, zone, d)
+	if err != nil {
+		return err
+	}
+	createReq.Tags = append(createReq.Tags, instanceServerGroupTag+groupID, instanceServerGroupTemplateTag+templateHash)
+
+	size := d.Get("size").(int)
+	maxSurge := d.Get("max_surge").(int)
+	maxUnavailable := d.Get("max_unavailable").(int)
+
+	for {
+		servers, err := listInstanceServerGroupServers(ctx, instanceAPI, zone, groupID)
+		if err != nil {
+			return err
+		}
+
+		var upToDate, outdated []*instance.Server
+		for _, server := range servers {
+			if instanceServerGroupServerUpToDate(server, templateHash) {
+				upToDate = append(upToDate, server)
+			} else {
+				outdated = append(outdated, server)
+			}
+		}
+
+		if len(upToDate) >= size {
+			// Rolling update is over, the remaining outdated and surplus servers can be removed.
+			for _, server := range append(outdated, upToDate[size:]...) {
+				err = deleteInstanceServerGroupServer(ctx, instanceAPI, server, timeout)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		toDelete, toCreate := instanceServerGroupRollStep(size, len(upToDate), len(outdated), maxSurge, maxUnavailable)
+		if toDelete == 0 && toCreate == 0 {
+			return fmt.Errorf("server group %s can't progress with max_surge=%d and max_unavailable=%d", groupID, maxSurge, maxUnavailable)
+		}
+
+		for _, server := range outdated[:toDelete] {
+			err = deleteInstanceServerGroupServer(ctx, instanceAPI, server, timeout)
+			if err != nil {
+				return err
+			}
+		}
+
+		for i := 0; i < toCreate; i++ {
+			err = createInstanceServerGroupServer(ctx, instanceAPI, createReq, d.Get("name").(string), userData, timeout)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// expandInstanceServerGroupTemplate returns the create server request and the user data shared by all the servers of the group.
+func expandInstanceServerGroupTemplate(ctx context.Context, instanceAPI *instance.API, meta interface{}, zone scw.Zone, d *schema.ResourceData) (*instance.CreateServerRequest, map[string]string, error) {
+	commercialType := d.Get("template.0.type").(string)
+
+	imageUUID := expandID(d.Get("template.0.image"))
+	if !scwvalidation.IsUUID(imageUUID) {
+		marketPlaceAPI := marketplace.NewAPI(meta.(*Meta).scwClient)
+		var err error
+		imageUUID, err = marketPlaceAPI.GetLocalImageIDByLabel(&marketplace.GetLocalImageIDByLabelRequest{
+			CommercialType: commercialType,
+			Zone:           zone,
+			ImageLabel:     imageUUID,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get image '%s': %s", newZonedID(zone, imageUUID), err)
+		}
+	}
+
+	req := &instance.CreateServerRequest{
+		Zone:              zone,
+		Project:           expandStringPtr(d.Get("project_id")),
+		Image:             imageUUID,
+		CommercialType:    commercialType,
+		SecurityGroup:     expandStringPtr(expandZonedID(d.Get("template.0.security_group_id")).ID),
+		PlacementGroup:    expandStringPtr(expandZonedID(d.Get("template.0.placement_group_id")).ID),
+		EnableIPv6:        d.Get("template.0.enable_ipv6").(bool),
+		DynamicIPRequired: scw.BoolPtr(d.Get("template.0.enable_dynamic_ip").(bool)),
+		Tags:              expandStrings(d.Get("template.0.tags")),
+	}
+
+	serverType := getServerType(ctx, instanceAPI, zone, commercialType)
+	if serverType == nil {
+		return nil, nil, fmt.Errorf("could not find a server type associated with %s", commercialType)
+	}
+
+	rootVolumeType := d.Get("template.0.root_volume_type").(string)
+	if rootVolumeType == "" {
+		if serverType.VolumesConstraint.MaxSize == 0 {
+			rootVolumeType = instance.VolumeVolumeTypeBSSD.String()
+		} else {
+			rootVolumeType = instance.VolumeVolumeTypeLSSD.String()
+		}
+	}
+
+	rootVolumeSize := scw.Size(uint64(d.Get("template.0.root_volume_size_in_gb").(int)) * gb)
+	if rootVolumeSize == 0 && rootVolumeType == instance.VolumeVolumeTypeLSSD.String() {
+		rootVolumeSize = serverType.VolumesConstraint.MaxSize
+	}
+
+	req.Volumes = map[string]*instance.VolumeServerTemplate{
+		"0": {
+			VolumeType: instance.VolumeVolumeType(rootVolumeType),
+			Size:       rootVolumeSize,
+		},
+	}
+	// Additional volumes are created along with each server, they are deleted with it.
+	for i, raw := range d.Get("template.0.additional_volume").([]interface{}) {
+		rawVolume := raw.(map[string]interface{})
+		req.Volumes[strconv.Itoa(i+1)] = &instance.VolumeServerTemplate{
+			VolumeType: instance.VolumeVolumeType(rawVolume["volume_type"].(string)),
+			Size:       scw.Size(uint64(rawVolume["size_in_gb"].(int)) * gb),
+		}
+	}
+	if err := validateLocalVolumeSizes(req.Volumes, serverType, commercialType); err != nil {
+		return nil, nil, err
+	}
+
+	userData := map[string]string{}
+	for key, value := range d.Get("template.0.user_data").(map[string]interface{}) {
+		userData[key] = value.(string)
+	}
+	if cloudInit, ok := d.GetOk("template.0.cloud_init"); ok {
+		userData["cloud-init"] = cloudInit.(string)
+	}
+
+	return req, userData, nil
+}
+
+// createInstanceServerGroupServer creates a server of the group and waits for it to be running.
+func createInstanceServerGroupServer(ctx context.Context, instanceAPI *instance.API, template *instance.CreateServerRequest, groupName string, userData map[string]string, timeout time.Duration) error {
+	req := *template
+	req.Name = newRandomName(groupName)
+	req.Volumes = make(map[string]*instance.VolumeServerTemplate, len(template.Volumes))
+	for key, volume := range template.Volumes {
+		volumeCopy := *volume
+		if key != "0" {
+			volumeCopy.Name = req.Name + "-vol-" + key
+		}
+		req.Volumes[key] = &volumeCopy
+	}
+	req.Volumes = sanitizeVolumeMap(req.Name, req.Volumes)
+
+	res, err := instanceAPI.CreateServer(&req, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForInstanceServer(ctx, instanceAPI, req.Zone, res.Server.ID, timeout)
+	if err != nil {
+		return err
+	}
+
+	if len(userData) > 0 {
+		userDataRequest := &instance.SetAllServerUserDataRequest{
+			Zone:     req.Zone,
+			ServerID: res.Server.ID,
+			UserData: make(map[string]io.Reader, len(userData)),
+		}
+		for key, value := range userData {
+			userDataRequest.UserData[key] = bytes.NewBufferString(value)
+		}
+
+		err = instanceAPI.SetAllServerUserData(userDataRequest, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return reachState(ctx, instanceAPI, req.Zone, res.Server.ID, instance.ServerStateRunning)
+}
+
+// deleteInstanceServerGroupServer stops and deletes a server of the group along with its volumes.
+func deleteInstanceServerGroupServer(ctx context.Context, instanceAPI *instance.API, server *instance.Server, timeout time.Duration) error {
+	zone := server.Zone
+
+	err := reachState(ctx, instanceAPI, zone, server.ID, instance.ServerStateStopped)
+	if is404Error(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForInstanceServer(ctx, instanceAPI, zone, server.ID, timeout)
+	if err != nil {
+		return err
+	}
+
+	err = instanceAPI.DeleteServer(&instance.DeleteServerRequest{
+		Zone:     zone,
+		ServerID: server.ID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return err
+	}
+
+	_, err = waitForInstanceServer(ctx, instanceAPI, zone, server.ID, timeout)
+	if err != nil && !is404Error(err) {
+		return err
+	}
+
+	for _, volume := range server.Volumes {
+		err = instanceAPI.DeleteVolume(&instance.DeleteVolumeRequest{
+			Zone:     zone,
+			VolumeID: volume.ID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listInstanceServerGroupServers returns the servers tagged as members of the group, sorted by creation date.
+func listInstanceServerGroupServers(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, groupID string) ([]*instance.Server, error) {
+	res, err := instanceAPI.ListServers(&instance.ListServersRequest{
+		Zone: zone,
+		Tags: []string{instanceServerGroupTag + groupID},
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res.Servers, func(i, j int) bool {
+		return res.Servers[i].CreationDate.Before(*res.Servers[j].CreationDate)
+	})
+
+	return res.Servers, nil
+}
+
+// instanceServerGroupTemplateHash returns a hash identifying a server group template.
+func instanceServerGroupTemplateHash(rawTemplate interface{}) (string, error) {
+	// Maps are marshaled with sorted keys, making the hash stable.
+	raw, err := json.Marshal(rawTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(schema.HashString(string(raw))), nil
+}
+
+// instanceServerGroupServerUpToDate returns true if the server runs and was created from the template with the given hash.
+func instanceServerGroupServerUpToDate(server *instance.Server, templateHash string) bool {
+	if server.State != instance.ServerStateRunning {
+		return false
+	}
+
+	for _, tag := range server.Tags {
+		if tag == instanceServerGroupTemplateTag+templateHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// instanceServerGroupRollStep returns how many outdated servers to delete and how many servers to create
+// for the next step of a rolling update, given the number of up-to-date and outdated servers of the group.
+// The group never goes above size+maxSurge servers nor below size-maxUnavailable servers.
+func instanceServerGroupRollStep(size, upToDate, outdated, maxSurge, maxUnavailable int) (int, int) {
+	total := upToDate + outdated
+
+	toCreate := size - upToDate
+	if surge := size + maxSurge - total; surge < toCreate {
+		toCreate = surge
+	}
+	if toCreate < 0 {
+		toCreate = 0
+	}
+
+	toDelete := outdated
+	if unavailable := total - (size - maxUnavailable); unavailable < toDelete {
+		toDelete = unavailable
+	}
+	if toDelete < 0 {
+		toDelete = 0
+	}
+
+	return toDelete, toCreate
+}
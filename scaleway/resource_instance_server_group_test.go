@@ -0,0 +1,37 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceServerGroupRollStep(t *testing.T) {
+	tests := []struct {
+		name           string
+		size           int
+		upToDate       int
+		outdated       int
+		maxSurge       int
+		maxUnavailable int
+		toDelete       int
+		toCreate       int
+	}{
+		{name: "scale up", size: 3, upToDate: 1, maxSurge: 1, toCreate: 2},
+		{name: "surge", size: 3, outdated: 3, maxSurge: 1, toCreate: 1},
+		{name: "surge full", size: 3, upToDate: 1, outdated: 3, maxSurge: 1, toDelete: 1},
+		{name: "unavailable", size: 3, outdated: 3, maxUnavailable: 1, toDelete: 1, toCreate: 0},
+		{name: "unavailable refill", size: 3, outdated: 2, maxUnavailable: 1, toCreate: 1},
+		{name: "surge and unavailable", size: 4, outdated: 4, maxSurge: 2, maxUnavailable: 2, toDelete: 2, toCreate: 2},
+		{name: "missing outdated", size: 2, outdated: 1, maxSurge: 1, toCreate: 2},
+		{name: "stuck", size: 3, outdated: 3, toDelete: 0, toCreate: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toDelete, toCreate := instanceServerGroupRollStep(tt.size, tt.upToDate, tt.outdated, tt.maxSurge, tt.maxUnavailable)
+			assert.Equal(t, tt.toDelete, toDelete)
+			assert.Equal(t, tt.toCreate, toCreate)
+		})
+	}
+}
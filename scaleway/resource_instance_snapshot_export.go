@@ -0,0 +1,193 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceSnapshotExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceSnapshotExportCreate,
+		ReadContext:   resourceScalewayInstanceSnapshotExportRead,
+		UpdateContext: resourceScalewayInstanceSnapshotExportUpdate,
+		DeleteContext: resourceScalewayInstanceSnapshotExportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceSnapshotWaitTimeout),
+			Delete:  schema.DefaultTimeout(defaultInstanceSnapshotWaitTimeout),
+			Default: schema.DefaultTimeout(defaultInstanceSnapshotWaitTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"snapshot_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the snapshot to export",
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The bucket the snapshot is exported to, it must be in the region of the snapshot",
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The key of the qcow2 object the snapshot is exported to",
+			},
+			"delete_object_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the exported object when the resource is destroyed",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the exported object in bytes",
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func resourceScalewayInstanceSnapshotExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotZonedID := datasourceNewZonedID(d.Get("snapshot_id"), zone)
+	zone, snapshotID, err := parseZonedID(snapshotZonedID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := instanceSnapshotExportS3Client(meta, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = exportInstanceSnapshot(ctx, instanceAPI, zone, snapshotID, d.Get("bucket").(string), d.Get("key").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = waitForInstanceSnapshotObject(ctx, instanceAPI, s3Client, zone, snapshotID, d.Get("bucket").(string), d.Get("key").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newInstanceSnapshotExportID(zone, snapshotID, d.Get("bucket").(string), d.Get("key").(string)))
+
+	return resourceScalewayInstanceSnapshotExportRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceSnapshotExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zone, snapshotID, bucket, key, err := parseInstanceSnapshotExportID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := instanceSnapshotExportS3Client(meta, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The export only exists as long as its object, the snapshot may have been deleted since.
+	object, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3Err(err, "NotFound", "") || isS3Err(err, s3.ErrCodeNoSuchKey, "") || isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("snapshot_id", newZonedIDString(zone, snapshotID))
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("key", key)
+	_ = d.Set("size", int(aws.Int64Value(object.ContentLength)))
+	_ = d.Set("zone", zone)
+
+	return nil
+}
+
+func resourceScalewayInstanceSnapshotExportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only delete_object_on_destroy can be updated, it is stored in the state.
+	return resourceScalewayInstanceSnapshotExportRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceSnapshotExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("delete_object_on_destroy").(bool) {
+		return nil
+	}
+
+	zone, _, bucket, key, err := parseInstanceSnapshotExportID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := instanceSnapshotExportS3Client(meta, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = s3Client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil && !isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// instanceSnapshotExportS3Client returns an s3 client for the region of the zone, where snapshots are exported.
+func instanceSnapshotExportS3Client(meta interface{}, zone scw.Zone) (*s3.S3, error) {
+	region, err := zone.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	return newS3ClientFromMetaWithRegion(meta.(*Meta), region)
+}
+
+// newInstanceSnapshotExportID returns the ID of an export, {zone}/{snapshot_id}/{bucket}/{key}.
+func newInstanceSnapshotExportID(zone scw.Zone, snapshotID, bucket, key string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", zone, snapshotID, bucket, key)
+}
+
+// parseInstanceSnapshotExportID parses the ID of an export, the key may contain slashes.
+func parseInstanceSnapshotExportID(id string) (zone scw.Zone, snapshotID, bucket, key string, err error) {
+	tab := strings.SplitN(id, "/", 4)
+	if len(tab) != 4 || tab[1] == "" || tab[2] == "" || tab[3] == "" {
+		return "", "", "", "", fmt.Errorf("cant parse snapshot export id: %s", id)
+	}
+
+	zone, err = scw.ParseZone(tab[0])
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	return zone, tab[1], tab[2], tab[3], nil
+}
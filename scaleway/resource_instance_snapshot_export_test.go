@@ -0,0 +1,27 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstanceSnapshotExportID(t *testing.T) {
+	id := newInstanceSnapshotExportID(scw.ZoneFrPar1, "11111111-1111-1111-1111-111111111111", "backups", "exports/2023/data.qcow2")
+	assert.Equal(t, "fr-par-1/11111111-1111-1111-1111-111111111111/backups/exports/2023/data.qcow2", id)
+
+	zone, snapshotID, bucket, key, err := parseInstanceSnapshotExportID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, scw.ZoneFrPar1, zone)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", snapshotID)
+	assert.Equal(t, "backups", bucket)
+	assert.Equal(t, "exports/2023/data.qcow2", key)
+
+	_, _, _, _, err = parseInstanceSnapshotExportID("fr-par-1/11111111-1111-1111-1111-111111111111")
+	assert.Error(t, err)
+	_, _, _, _, err = parseInstanceSnapshotExportID("fr-par-1/11111111-1111-1111-1111-111111111111/backups/")
+	assert.Error(t, err)
+	_, _, _, _, err = parseInstanceSnapshotExportID("unknown/11111111-1111-1111-1111-111111111111/backups/data.qcow2")
+	assert.Error(t, err)
+}
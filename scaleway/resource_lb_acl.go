@@ -0,0 +1,174 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayLbACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayLbACLCreate,
+		ReadContext:   resourceScalewayLbACLRead,
+		UpdateContext: resourceScalewayLbACLUpdate,
+		DeleteContext: resourceScalewayLbACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultLbLbTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"frontend_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The frontend ID on which the ACL is applied",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ACL name",
+			},
+			"index": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The priority of the ACL, ACLs are applied in ascending order",
+			},
+			"action": lbACLActionSchema(),
+			"match":  lbACLMatchSchema(),
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of ACL's creation (RFC 3339 format)",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of ACL's update (RFC 3339 format)",
+			},
+		},
+		CustomizeDiff: resourceScalewayLbACLCustomizeDiff,
+	}
+}
+
+// resourceScalewayLbACLCustomizeDiff checks that the redirect block matches the action type.
+func resourceScalewayLbACLCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("action.0.type") {
+		return nil
+	}
+	return validateLbACLAction(expandLbACLAction(diff.Get("action")))
+}
+
+func resourceScalewayLbACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, _, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, frontID, err := parseZonedID(d.Get("frontend_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl := expandLbACL(map[string]interface{}{
+		"name":   d.Get("name"),
+		"match":  d.Get("match"),
+		"action": d.Get("action"),
+	})
+
+	res, err := lbAPI.CreateACL(&lbSDK.ZonedAPICreateACLRequest{
+		Zone:       zone,
+		FrontendID: frontID,
+		Name:       expandOrGenerateString(acl.Name, "lb-acl"),
+		Action:     acl.Action,
+		Match:      acl.Match,
+		Index:      int32(d.Get("index").(int)),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newZonedIDString(zone, res.ID))
+
+	return resourceScalewayLbACLRead(ctx, d, meta)
+}
+
+func resourceScalewayLbACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl, err := lbAPI.GetACL(&lbSDK.ZonedAPIGetACLRequest{
+		Zone:  zone,
+		ACLID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("frontend_id", newZonedIDString(zone, acl.Frontend.ID))
+	_ = d.Set("name", acl.Name)
+	_ = d.Set("index", int(acl.Index))
+	_ = d.Set("match", flattenLbACLMatch(acl.Match))
+	_ = d.Set("action", flattenLbACLAction(acl.Action))
+	_ = d.Set("created_at", flattenTime(acl.CreatedAt))
+	_ = d.Set("updated_at", flattenTime(acl.UpdatedAt))
+
+	return nil
+}
+
+func resourceScalewayLbACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl := expandLbACL(map[string]interface{}{
+		"name":   d.Get("name"),
+		"match":  d.Get("match"),
+		"action": d.Get("action"),
+	})
+
+	_, err = lbAPI.UpdateACL(&lbSDK.ZonedAPIUpdateACLRequest{
+		Zone:   zone,
+		ACLID:  ID,
+		Name:   acl.Name,
+		Action: acl.Action,
+		Match:  acl.Match,
+		Index:  int32(d.Get("index").(int)),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayLbACLRead(ctx, d, meta)
+}
+
+func resourceScalewayLbACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = lbAPI.DeleteACL(&lbSDK.ZonedAPIDeleteACLRequest{
+		Zone:  zone,
+		ACLID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
@@ -17,6 +19,9 @@ func resourceScalewayLbCertificate() *schema.Resource {
 		ReadContext:   resourceScalewayLbCertificateRead,
 		UpdateContext: resourceScalewayLbCertificateUpdate,
 		DeleteContext: resourceScalewayLbCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		SchemaVersion: 1,
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -69,12 +74,13 @@ func resourceScalewayLbCertificate() *schema.Resource {
 				},
 			},
 			"custom_certificate": {
-				ConflictsWith: []string{"letsencrypt"},
-				MaxItems:      1,
-				Type:          schema.TypeList,
-				Description:   "The custom type certificate type configuration",
-				Optional:      true,
-				ForceNew:      true,
+				ConflictsWith:    []string{"letsencrypt"},
+				MaxItems:         1,
+				Type:             schema.TypeList,
+				Description:      "The custom type certificate type configuration",
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: lbCertificateDiffSuppressImportedChain,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"certificate_chain": {
@@ -86,6 +92,14 @@ func resourceScalewayLbCertificate() *schema.Resource {
 				},
 			},
 
+			"expiration_warning_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressFuncDuration,
+				ValidateFunc:     validateDuration(),
+				Description:      "Emit a warning when the certificate expires within this duration",
+			},
+
 			// Readonly attributes
 			"common_name": {
 				Type:        schema.TypeString,
@@ -122,7 +136,51 @@ func resourceScalewayLbCertificate() *schema.Resource {
 				Description: "The status of certificate",
 			},
 		},
+		CustomizeDiff: resourceScalewayLbCertificateCustomizeDiff,
+	}
+}
+
+// resourceScalewayLbCertificateCustomizeDiff parses the custom certificate chain to validate it and
+// to compute its attributes at plan time.
+func resourceScalewayLbCertificateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	chain, exist := diff.GetOk("custom_certificate.0.certificate_chain")
+	if !exist || !diff.HasChange("custom_certificate") {
+		return nil
+	}
+
+	certificate, err := parseLbCertificateChain(chain.(string))
+	if err != nil {
+		return fmt.Errorf("invalid custom_certificate.0.certificate_chain: %w", err)
+	}
+
+	if err := diff.SetNew("common_name", certificate.Subject.CommonName); err != nil {
+		return err
+	}
+	if err := diff.SetNew("subject_alternative_name", flattenLbCertificateSubjectAlternativeNames(certificate)); err != nil {
+		return err
+	}
+	if err := diff.SetNew("not_valid_before", certificate.NotBefore.UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return diff.SetNew("not_valid_after", certificate.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// lbCertificateDiffSuppressImportedChain keeps an imported custom certificate, which has no chain in state,
+// when the configured chain holds the same certificate.
+func lbCertificateDiffSuppressImportedChain(_, _, _ string, d *schema.ResourceData) bool {
+	oldChain, newChain := d.GetChange("custom_certificate.0.certificate_chain")
+	if d.Id() == "" || oldChain.(string) != "" || newChain.(string) == "" {
+		return false
 	}
+
+	certificate, err := parseLbCertificateChain(newChain.(string))
+	if err != nil {
+		return false
+	}
+
+	return d.Get("common_name").(string) == certificate.Subject.CommonName &&
+		d.Get("not_valid_before").(string) == certificate.NotBefore.UTC().Format(time.RFC3339) &&
+		d.Get("not_valid_after").(string) == certificate.NotAfter.UTC().Format(time.RFC3339)
 }
 
 func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -217,7 +275,38 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("not_valid_after", flattenTime(certificate.NotValidAfter))
 	_ = d.Set("status", certificate.Status)
 
-	return nil
+	// set the letsencrypt configuration of imported certificates
+	if _, exist := d.GetOk("letsencrypt"); !exist && certificate.Type == lbSDK.CertificateTypeLetsencryt {
+		_ = d.Set("letsencrypt", []map[string]interface{}{
+			{
+				"common_name":              certificate.CommonName,
+				"subject_alternative_name": certificate.SubjectAlternativeName,
+			},
+		})
+	}
+
+	return lbCertificateExpirationDiagnostics(d, certificate)
+}
+
+// lbCertificateExpirationDiagnostics warns when the certificate expires within the configured threshold.
+func lbCertificateExpirationDiagnostics(d *schema.ResourceData, certificate *lbSDK.Certificate) diag.Diagnostics {
+	threshold, err := expandDuration(d.Get("expiration_warning_threshold"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if threshold == nil || certificate.NotValidAfter == nil || time.Until(*certificate.NotValidAfter) > *threshold {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("certificate %s expires soon", certificate.ID),
+			Detail:        fmt.Sprintf("certificate for %s is not valid after %s", certificate.CommonName, certificate.NotValidAfter.Format(time.RFC3339)),
+			AttributePath: cty.GetAttrPath("not_valid_after"),
+		},
+	}
 }
 
 func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
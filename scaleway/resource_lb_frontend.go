@@ -2,10 +2,12 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"sort"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,6 +34,7 @@ func resourceScalewayLbFrontend() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: lbUpgradeV1SchemaType(), Upgrade: lbUpgradeV1SchemaUpgradeFunc},
 		},
+		CustomizeDiff: resourceScalewayLbFrontendCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"lb_id": {
 				Type:         schema.TypeString,
@@ -93,79 +96,18 @@ func resourceScalewayLbFrontend() *schema.Resource {
 							Computed:    true,
 							Description: "The ACL name",
 						},
-						"action": {
-							Type:        schema.TypeList,
-							Required:    true,
-							Description: "Action to undertake when an ACL filter matches",
-							MaxItems:    1,
-							MinItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											lbSDK.ACLActionTypeAllow.String(),
-											lbSDK.ACLActionTypeDeny.String(),
-										}, false),
-										Description: "The action type",
-									},
-								},
-							},
-						},
-						"match": {
-							Type:        schema.TypeList,
-							Required:    true,
-							MaxItems:    1,
-							MinItems:    1,
-							Description: "The ACL match rule",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"ip_subnet": {
-										Type: schema.TypeList,
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-										Optional:    true,
-										Description: "A list of IPs or CIDR v4/v6 addresses of the client of the session to match",
-									},
-									"http_filter": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  lbSDK.ACLHTTPFilterACLHTTPFilterNone.String(),
-										ValidateFunc: validation.StringInSlice([]string{
-											lbSDK.ACLHTTPFilterACLHTTPFilterNone.String(),
-											lbSDK.ACLHTTPFilterPathBegin.String(),
-											lbSDK.ACLHTTPFilterPathEnd.String(),
-											lbSDK.ACLHTTPFilterRegex.String(),
-											lbSDK.ACLHTTPFilterHTTPHeaderMatch.String(),
-										}, false),
-										Description: "The HTTP filter to match",
-									},
-									"http_filter_value": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "A list of possible values to match for the given HTTP filter",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"http_filter_option": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "You can use this field with http_header_match acl type to set the header name to filter",
-									},
-									"invert": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Description: `If set to true, the condition will be of type "unless"`,
-									},
-								},
-							},
-						},
+						"action": lbACLActionSchema(),
+						"match":  lbACLMatchSchema(),
 					},
 				},
 			},
+			"external_acls": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"acl"},
+				Description:   "This boolean determines if ACLs should be managed externally through the 'lb_acl' resource. If set to `true`, `acl` attribute cannot be set directly in the lb frontend",
+			},
 			"enable_http3": {
 				Type:        schema.TypeBool,
 				Description: "Activates HTTP/3 protocol",
@@ -223,9 +165,11 @@ func resourceScalewayLbFrontendCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(newZonedIDString(zone, frontend.ID))
 
-	diagnostics := resourceScalewayLbFrontendUpdateACL(ctx, d, lbAPI, zone, frontend.ID)
-	if diagnostics != nil {
-		return diagnostics
+	if !d.Get("external_acls").(bool) {
+		diagnostics := resourceScalewayLbFrontendUpdateACL(ctx, d, lbAPI, zone, frontend.ID)
+		if diagnostics != nil {
+			return diagnostics
+		}
 	}
 
 	return resourceScalewayLbFrontendRead(ctx, d, meta)
@@ -266,16 +210,87 @@ func resourceScalewayLbFrontendRead(ctx context.Context, d *schema.ResourceData,
 		_ = d.Set("certificate_ids", flattenSliceIDs(frontend.CertificateIDs, zone))
 	}
 
-	// read related acls.
-	resACL, err := lbAPI.ListACLs(&lbSDK.ZonedAPIListACLsRequest{
-		Zone:       zone,
-		FrontendID: ID,
-	}, scw.WithAllPages(), scw.WithContext(ctx))
+	// read related acls, unless they are managed by scaleway_lb_acl resources.
+	if !d.Get("external_acls").(bool) {
+		resACL, err := lbAPI.ListACLs(&lbSDK.ZonedAPIListACLsRequest{
+			Zone:       zone,
+			FrontendID: ID,
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = d.Set("acl", flattenLBACLs(resACL.ACLs))
+	}
+
+	return lbFrontendHTTP3Diagnostics(frontend)
+}
+
+// lbFrontendHTTP3Diagnostics warns when HTTP/3 is enabled on a frontend that cannot serve it.
+func lbFrontendHTTP3Diagnostics(frontend *lbSDK.Frontend) diag.Diagnostics {
+	if !frontend.EnableHTTP3 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	if len(frontend.CertificateIDs) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("HTTP/3 is enabled on frontend %s without certificate", frontend.ID),
+			Detail:        "HTTP/3 runs over QUIC which requires TLS, attach at least one certificate with certificate_ids",
+			AttributePath: cty.GetAttrPath("enable_http3"),
+		})
+	}
+	if frontend.Backend != nil && frontend.Backend.ForwardProtocol != lbSDK.ProtocolHTTP {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("HTTP/3 is enabled on frontend %s with a %s backend", frontend.ID, frontend.Backend.ForwardProtocol),
+			Detail:        "HTTP/3 is only served when the backend forward protocol is http",
+			AttributePath: cty.GetAttrPath("enable_http3"),
+		})
+	}
+
+	return diags
+}
+
+// resourceScalewayLbFrontendCustomizeDiff checks the ACLs against the backend forward protocol.
+func resourceScalewayLbFrontendCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("backend_id") || !diff.HasChange("acl") {
+		return nil
+	}
+
+	checkACLs := false
+	for _, acl := range expandsLBACLs(diff.Get("acl")) {
+		if lbACLRequiresHTTP(acl) {
+			checkACLs = true
+			break
+		}
+	}
+	if !checkACLs {
+		return nil
+	}
+
+	zone, backendID, err := parseZonedID(diff.Get("backend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		// a plain backend ID is looked up in the provider default zone, as in create
+		defaultZone, exist := meta.(*Meta).scwClient.GetDefaultZone()
+		if !exist {
+			return ErrZoneNotFound
+		}
+		zone, backendID = defaultZone, expandID(diff.Get("backend_id"))
 	}
 
-	_ = d.Set("acl", flattenLBACLs(resACL.ACLs))
+	backend, err := lbSDK.NewZonedAPI(meta.(*Meta).scwClient).GetBackend(&lbSDK.ZonedAPIGetBackendRequest{
+		Zone:      zone,
+		BackendID: backendID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if backend.ForwardProtocol != lbSDK.ProtocolHTTP {
+		return fmt.Errorf("backend %s forwards %s traffic: acl http filters and redirect actions require an http backend", backend.ID, backend.ForwardProtocol)
+	}
 
 	return nil
 }
@@ -418,9 +433,11 @@ func resourceScalewayLbFrontendUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	diagnostics := resourceScalewayLbFrontendUpdateACL(ctx, d, lbAPI, zone, ID)
-	if diagnostics != nil {
-		return diagnostics
+	if !d.Get("external_acls").(bool) {
+		diagnostics := resourceScalewayLbFrontendUpdateACL(ctx, d, lbAPI, zone, ID)
+		if diagnostics != nil {
+			return diagnostics
+		}
 	}
 
 	return resourceScalewayLbFrontendRead(ctx, d, meta)
@@ -40,9 +40,16 @@ func resourceScalewayLbRoute() *schema.Resource {
 				Description:  "The backend ID destination of redirection",
 			},
 			"match_sni": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The domain to match against",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Server Name Indication TLS extension field from an incoming connection made via an SSL/TLS transport layer",
+				ConflictsWith: []string{"match_host_header"},
+			},
+			"match_host_header": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Specifies the host of the server to which the request is being sent",
+				ConflictsWith: []string{"match_sni"},
 			},
 		},
 	}
@@ -73,7 +80,8 @@ func resourceScalewayLbRouteCreate(ctx context.Context, d *schema.ResourceData,
 		FrontendID: frontID,
 		BackendID:  backID,
 		Match: &lbSDK.RouteMatch{
-			Sni: expandStringPtr(d.Get("match_sni")),
+			Sni:        expandStringPtr(d.Get("match_sni")),
+			HostHeader: expandStringPtr(d.Get("match_host_header")),
 		},
 	}
 
@@ -107,8 +115,9 @@ func resourceScalewayLbRouteRead(ctx context.Context, d *schema.ResourceData, me
 
 	_ = d.Set("frontend_id", newZonedIDString(zone, route.FrontendID))
 	_ = d.Set("backend_id", newZonedIDString(zone, route.BackendID))
-	if route.Match != nil && route.Match.Sni != nil {
-		_ = d.Set("match_sni", route.Match.Sni)
+	if route.Match != nil {
+		_ = d.Set("match_sni", flattenStringPtr(route.Match.Sni))
+		_ = d.Set("match_host_header", flattenStringPtr(route.Match.HostHeader))
 	}
 
 	return nil
@@ -134,7 +143,8 @@ func resourceScalewayLbRouteUpdate(ctx context.Context, d *schema.ResourceData,
 		RouteID:   ID,
 		BackendID: backID,
 		Match: &lbSDK.RouteMatch{
-			Sni: expandStringPtr(d.Get("match_sni")),
+			Sni:        expandStringPtr(d.Get("match_sni")),
+			HostHeader: expandStringPtr(d.Get("match_host_header")),
 		},
 	}
 
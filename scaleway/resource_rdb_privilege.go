@@ -3,7 +3,11 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +16,10 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
+// rdbPrivilegeAllDatabases is the database name used in the resource identifier
+// when the privilege is managed on every database of the instance.
+const rdbPrivilegeAllDatabases = "*"
+
 func resourceScalewayRdbPrivilege() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalewayRdbPrivilegeCreate,
@@ -28,7 +36,10 @@ func resourceScalewayRdbPrivilege() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultRdbInstanceTimeout),
 			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
 		},
-		SchemaVersion: 0,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{Version: 0, Type: rdbPrivilegeUpgradeV1SchemaType(), Upgrade: rdbPrivilegeV1SchemaUpgradeFunc},
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:         schema.TypeString,
@@ -41,11 +52,31 @@ func resourceScalewayRdbPrivilege() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "User name",
 				Required:    true,
+				ForceNew:    true,
 			},
 			"database_name": {
-				Type:        schema.TypeString,
-				Description: "Database name",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "Database name",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"database_name", "database_names", "all_databases"},
+			},
+			"database_names": {
+				Type:        schema.TypeSet,
+				Description: "Names of the databases on which the permission is granted",
+				Optional:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ExactlyOneOf: []string{"database_name", "database_names", "all_databases"},
+			},
+			"all_databases": {
+				Type:         schema.TypeBool,
+				Description:  "Grant the permission on every database of the instance, including the ones created later",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"database_name", "database_names", "all_databases"},
 			},
 			"permission": {
 				Type:        schema.TypeString,
@@ -77,52 +108,37 @@ func resourceScalewayRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	userName, _ := d.Get("user_name").(string)
-	createReq := &rdb.SetPrivilegeRequest{
-		Region:       region,
-		InstanceID:   instanceID,
-		DatabaseName: d.Get("database_name").(string),
-		UserName:     userName,
-		Permission:   rdb.Permission(d.Get("permission").(string)),
-	}
+	databaseName := expandRdbPrivilegeDatabaseName(d)
 
-	//  wrapper around StateChangeConf that will just retry  write on database
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, errSetPrivilege := rdbAPI.SetPrivilege(createReq, scw.WithContext(ctx))
-		if errSetPrivilege != nil {
-			if is409Error(errSetPrivilege) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errSetPrivilege)
-			}
-			return resource.NonRetryableError(errSetPrivilege)
-		}
-		return nil
-	})
+	databaseNames, err := rdbPrivilegeDatabaseNames(ctx, rdbAPI, region, instanceID, databaseName, d.Get("database_names"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	permission := rdb.Permission(d.Get("permission").(string))
+	for _, name := range databaseNames {
+		err = rdbPrivilegeSet(ctx, rdbAPI, region, instanceID, name, userName, permission, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(newRegionalIDString(region, instanceID))
+	d.SetId(resourceScalewayRdbPrivilegeID(region, instanceID, databaseName, userName))
 	return resourceScalewayRdbPrivilegeRead(ctx, d, meta)
 }
 
 func resourceScalewayRdbPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI := newRdbAPI(meta)
-	region, instanceID, err := parseRegionalID(d.Get("instance_id").(string))
+	region, instanceID, databaseName, userName, err := resourceScalewayRdbPrivilegeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	dbName, _ := d.Get("database_name").(string)
-	userName, _ := d.Get("user_name").(string)
-
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		return diag.FromErr(err)
@@ -142,10 +158,15 @@ func resourceScalewayRdbPrivilegeRead(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
+	switch databaseName {
+	case "", rdbPrivilegeAllDatabases:
+		return resourceScalewayRdbPrivilegeReadDatabases(ctx, d, rdbAPI, region, instanceID, databaseName, userName)
+	}
+
 	res, err := rdbAPI.ListPrivileges(&rdb.ListPrivilegesRequest{
 		Region:       region,
 		InstanceID:   instanceID,
-		DatabaseName: &dbName,
+		DatabaseName: &databaseName,
 		UserName:     &userName,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -157,12 +178,62 @@ func resourceScalewayRdbPrivilegeRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if len(res.Privileges) == 0 {
-		return diag.FromErr(fmt.Errorf("couldn't retrieve privileges for user[%s] on database [%s]", userName, dbName))
+		return diag.FromErr(fmt.Errorf("couldn't retrieve privileges for user[%s] on database [%s]", userName, databaseName))
 	}
 	privilege := res.Privileges[0]
 	_ = d.Set("database_name", privilege.DatabaseName)
 	_ = d.Set("user_name", privilege.UserName)
 	_ = d.Set("permission", privilege.Permission)
+	_ = d.Set("all_databases", false)
+	_ = d.Set("instance_id", newRegionalIDString(region, instanceID))
+
+	return nil
+}
+
+// resourceScalewayRdbPrivilegeReadDatabases reconciles the state of a privilege managed on several databases.
+// The permission is only kept in state when every targeted database has it, otherwise the first
+// diverging permission is stored so the next plan converges the databases back to the configuration.
+func resourceScalewayRdbPrivilegeReadDatabases(ctx context.Context, d *schema.ResourceData, rdbAPI *rdb.API, region scw.Region, instanceID, databaseName, userName string) diag.Diagnostics {
+	res, err := rdbAPI.ListPrivileges(&rdb.ListPrivilegesRequest{
+		Region:     region,
+		InstanceID: instanceID,
+		UserName:   &userName,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	permissions := make(map[string]string, len(res.Privileges))
+	for _, privilege := range res.Privileges {
+		permissions[privilege.DatabaseName] = privilege.Permission.String()
+	}
+
+	var databaseNames []string
+	if databaseName == rdbPrivilegeAllDatabases {
+		databaseNames, err = rdbPrivilegeDatabaseNames(ctx, rdbAPI, region, instanceID, databaseName, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		databaseNames = expandStrings(d.Get("database_names").(*schema.Set).List())
+		if len(databaseNames) == 0 {
+			// Imported resource: adopt every database on which the user has a permission
+			for name, permission := range permissions {
+				if permission != rdb.PermissionNone.String() {
+					databaseNames = append(databaseNames, name)
+				}
+			}
+		}
+		_ = d.Set("database_names", databaseNames)
+	}
+
+	_ = d.Set("user_name", userName)
+	_ = d.Set("all_databases", databaseName == rdbPrivilegeAllDatabases)
+	_ = d.Set("permission", rdbPrivilegeReconcilePermission(d.Get("permission").(string), databaseNames, permissions))
 	_ = d.Set("instance_id", newRegionalIDString(region, instanceID))
 
 	return nil
@@ -170,7 +241,7 @@ func resourceScalewayRdbPrivilegeRead(ctx context.Context, d *schema.ResourceDat
 
 func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI := newRdbAPI(meta)
-	region, instanceID, err := parseRegionalID(d.Get("instance_id").(string))
+	region, instanceID, databaseName, userName, err := resourceScalewayRdbPrivilegeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -180,7 +251,6 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	userName, _ := d.Get("user_name").(string)
 	listUsers, err := rdbAPI.ListUsers(&rdb.ListUsersRequest{
 		Region:     region,
 		InstanceID: instanceID,
@@ -199,33 +269,29 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 		return nil
 	}
 
-	updateReq := &rdb.SetPrivilegeRequest{
-		Region:       region,
-		InstanceID:   instanceID,
-		DatabaseName: d.Get("database_name").(string),
-		UserName:     userName,
-		Permission:   rdb.Permission(d.Get("permission").(string)),
-	}
-
-	//  wrapper around StateChangeConf that will just retry the database creation
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
-		if errSet != nil {
-			if is409Error(errSet) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
-				if errWait != nil {
-					return resource.NonRetryableError(errWait)
-				}
-				return resource.RetryableError(errSet)
+	if d.HasChange("database_names") {
+		oldNames, newNames := d.GetChange("database_names")
+		for _, name := range expandStrings(oldNames.(*schema.Set).Difference(newNames.(*schema.Set)).List()) {
+			err = rdbPrivilegeSet(ctx, rdbAPI, region, instanceID, name, userName, rdb.PermissionNone, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
 			}
-			return resource.NonRetryableError(errSet)
 		}
-		return nil
-	})
+	}
+
+	databaseNames, err := rdbPrivilegeDatabaseNames(ctx, rdbAPI, region, instanceID, databaseName, d.Get("database_names"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	permission := rdb.Permission(d.Get("permission").(string))
+	for _, name := range databaseNames {
+		err = rdbPrivilegeSet(ctx, rdbAPI, region, instanceID, name, userName, permission, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
@@ -237,7 +303,7 @@ func resourceScalewayRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceD
 //gocyclo:ignore
 func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI := newRdbAPI(meta)
-	region, instanceID, err := parseRegionalID(d.Get("instance_id").(string))
+	region, instanceID, databaseName, userName, err := resourceScalewayRdbPrivilegeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -248,7 +314,6 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	_ = d.Set("permission", rdb.PermissionNone)
-	userName, _ := d.Get("user_name").(string)
 	listUsers, err := rdbAPI.ListUsers(&rdb.ListUsersRequest{
 		Region:     region,
 		InstanceID: instanceID,
@@ -267,55 +332,199 @@ func resourceScalewayRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceD
 		return nil
 	}
 
-	updateReq := &rdb.SetPrivilegeRequest{
-		Region:       region,
-		InstanceID:   instanceID,
-		DatabaseName: d.Get("database_name").(string),
-		UserName:     userName,
-		Permission:   rdb.PermissionNone,
+	databaseNames, err := rdbPrivilegeDatabaseNames(ctx, rdbAPI, region, instanceID, databaseName, d.Get("database_names"))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	//  wrapper around StateChangeConf that will just retry the database creation
-	err = resource.RetryContext(ctx, defaultRdbInstanceTimeout, func() *resource.RetryError {
-		// check if user exist on retry
-		listUsers, errUserExist := rdbAPI.ListUsers(&rdb.ListUsersRequest{
-			Region:     region,
-			InstanceID: instanceID,
-			Name:       &userName,
-		}, scw.WithContext(ctx))
-		if err != nil {
-			if is404Error(err) {
+	for _, name := range databaseNames {
+		updateReq := &rdb.SetPrivilegeRequest{
+			Region:       region,
+			InstanceID:   instanceID,
+			DatabaseName: name,
+			UserName:     userName,
+			Permission:   rdb.PermissionNone,
+		}
+
+		//  wrapper around StateChangeConf that will just retry the database creation
+		err = resource.RetryContext(ctx, defaultRdbInstanceTimeout, func() *resource.RetryError {
+			// check if user exist on retry
+			listUsers, errUserExist := rdbAPI.ListUsers(&rdb.ListUsersRequest{
+				Region:     region,
+				InstanceID: instanceID,
+				Name:       &userName,
+			}, scw.WithContext(ctx))
+			if errUserExist != nil {
+				if is404Error(errUserExist) {
+					d.SetId("")
+					return nil
+				}
+				return resource.NonRetryableError(errUserExist)
+			}
+
+			if listUsers != nil && len(listUsers.Users) == 0 {
 				d.SetId("")
 				return nil
 			}
-			return resource.NonRetryableError(errUserExist)
+			_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
+			if errSet != nil {
+				if is409Error(errSet) {
+					_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
+					if errWait != nil {
+						return resource.NonRetryableError(errWait)
+					}
+					return resource.RetryableError(errSet)
+				}
+				return resource.NonRetryableError(errSet)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if listUsers != nil && len(listUsers.Users) == 0 {
-			d.SetId("")
+		if d.Id() == "" {
 			return nil
 		}
-		_, errSet := rdbAPI.SetPrivilege(updateReq, scw.WithContext(ctx))
-		if errSet != nil {
-			if is409Error(errSet) {
-				_, errWait := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
+	}
+
+	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// rdbPrivilegeSet sets the permission of a user on a database, retrying while the instance is busy.
+func rdbPrivilegeSet(ctx context.Context, api *rdb.API, region scw.Region, instanceID, databaseName, userName string, permission rdb.Permission, timeout time.Duration) error {
+	setReq := &rdb.SetPrivilegeRequest{
+		Region:       region,
+		InstanceID:   instanceID,
+		DatabaseName: databaseName,
+		UserName:     userName,
+		Permission:   permission,
+	}
+
+	//  wrapper around StateChangeConf that will just retry write on database
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, errSetPrivilege := api.SetPrivilege(setReq, scw.WithContext(ctx))
+		if errSetPrivilege != nil {
+			if is409Error(errSetPrivilege) {
+				_, errWait := waitForRDBInstance(ctx, api, region, instanceID, timeout)
 				if errWait != nil {
 					return resource.NonRetryableError(errWait)
 				}
-				return resource.RetryableError(errSet)
+				return resource.RetryableError(errSetPrivilege)
 			}
-			return resource.NonRetryableError(errSet)
+			return resource.NonRetryableError(errSetPrivilege)
 		}
 		return nil
 	})
-	if err != nil {
-		return diag.FromErr(err)
+}
+
+// rdbPrivilegeDatabaseNames returns the databases targeted by a privilege.
+// databaseName is the one stored in the resource identifier, databaseNames the raw `database_names` set.
+func rdbPrivilegeDatabaseNames(ctx context.Context, api *rdb.API, region scw.Region, instanceID, databaseName string, databaseNames interface{}) ([]string, error) {
+	switch databaseName {
+	case "":
+		if databaseNames == nil {
+			return nil, nil
+		}
+		return expandStrings(databaseNames.(*schema.Set).List()), nil
+	case rdbPrivilegeAllDatabases:
+		res, err := api.ListDatabases(&rdb.ListDatabasesRequest{
+			Region:     region,
+			InstanceID: instanceID,
+		}, scw.WithAllPages(), scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, 0, len(res.Databases))
+		for _, database := range res.Databases {
+			names = append(names, database.Name)
+		}
+		return names, nil
+	default:
+		return []string{databaseName}, nil
 	}
+}
 
-	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
+// rdbPrivilegeReconcilePermission returns the permission to store in state for a privilege spanning several databases.
+// A database missing from permissions is considered as having no permission.
+func rdbPrivilegeReconcilePermission(expected string, databaseNames []string, permissions map[string]string) string {
+	names := append([]string(nil), databaseNames...)
+	sort.Strings(names)
+
+	for _, name := range names {
+		permission, exist := permissions[name]
+		if !exist {
+			permission = rdb.PermissionNone.String()
+		}
+		if permission != expected {
+			return permission
+		}
+	}
+
+	return expected
+}
+
+// expandRdbPrivilegeDatabaseName returns the database name to store in the resource identifier.
+func expandRdbPrivilegeDatabaseName(d *schema.ResourceData) string {
+	if d.Get("all_databases").(bool) {
+		return rdbPrivilegeAllDatabases
+	}
+	return d.Get("database_name").(string)
+}
+
+// Build the resource identifier
+// The resource identifier format is "Region/InstanceId/DatabaseName/UserName",
+// DatabaseName is "*" for every database and the part is omitted when the databases are listed in `database_names`.
+func resourceScalewayRdbPrivilegeID(region scw.Region, instanceID, databaseName, userName string) (resourceID string) {
+	if databaseName == "" {
+		return fmt.Sprintf("%s/%s/%s", region, instanceID, userName)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", region, instanceID, databaseName, userName)
+}
+
+// Extract instance ID, database and user name from the resource identifier.
+// The resource identifier format is "Region/InstanceId/DatabaseName/UserName" or "Region/InstanceId/UserName"
+func resourceScalewayRdbPrivilegeParseID(resourceID string) (region scw.Region, instanceID, databaseName, userName string, err error) {
+	idParts := strings.Split(resourceID, "/")
+	switch len(idParts) {
+	case 3:
+		return scw.Region(idParts[0]), idParts[1], "", idParts[2], nil
+	case 4:
+		return scw.Region(idParts[0]), idParts[1], idParts[2], idParts[3], nil
+	default:
+		return "", "", "", "", fmt.Errorf("can't parse privilege resource id: %s", resourceID)
+	}
+}
+
+func rdbPrivilegeUpgradeV1SchemaType() cty.Type {
+	return cty.Object(map[string]cty.Type{
+		"id":            cty.String,
+		"database_name": cty.String,
+		"user_name":     cty.String,
+	})
+}
+
+// rdbPrivilegeV1SchemaUpgradeFunc allow upgrade the privilege ID from the instance regional ID to "Region/InstanceId/DatabaseName/UserName".
+func rdbPrivilegeV1SchemaUpgradeFunc(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	idRaw, exist := rawState["id"]
+	if !exist {
+		return nil, fmt.Errorf("upgrade: id not exist")
+	}
+
+	region, instanceID, err := parseRegionalID(idRaw.(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, fmt.Errorf("upgrade: could not parse privilege id `%s`: %w", idRaw, err)
 	}
 
-	return nil
+	databaseName, _ := rawState["database_name"].(string)
+	userName, _ := rawState["user_name"].(string)
+	rawState["id"] = resourceScalewayRdbPrivilegeID(region, instanceID, databaseName, userName)
+
+	return rawState, nil
 }
@@ -1,12 +1,16 @@
 package scaleway
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccScalewayRdbPrivilege_Basic(t *testing.T) {
@@ -212,3 +216,72 @@ func testAccCheckRdbPrivilegeExists(tt *TestTools, instance string, database str
 		return nil
 	}
 }
+
+func TestResourceScalewayRdbPrivilegeParseIDWithWronglyFormatedIdReturnError(t *testing.T) {
+	assert := assert.New(t)
+	region, _, _, _, err := resourceScalewayRdbPrivilegeParseID("notandid")
+	assert.Error(err)
+	assert.Empty(region)
+	assert.Equal("can't parse privilege resource id: notandid", err.Error())
+}
+
+func TestResourceScalewayRdbPrivilegeParseID(t *testing.T) {
+	assert := assert.New(t)
+	region, instanceID, dbname, username, err := resourceScalewayRdbPrivilegeParseID("region/instanceid/dbname/username")
+	assert.NoError(err)
+	assert.Equal(scw.Region("region"), region)
+	assert.Equal("instanceid", instanceID)
+	assert.Equal("dbname", dbname)
+	assert.Equal("username", username)
+
+	region, instanceID, dbname, username, err = resourceScalewayRdbPrivilegeParseID("region/instanceid/username")
+	assert.NoError(err)
+	assert.Equal(scw.Region("region"), region)
+	assert.Equal("instanceid", instanceID)
+	assert.Empty(dbname)
+	assert.Equal("username", username)
+}
+
+func TestResourceScalewayRdbPrivilegeID(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("fr-par/instanceid/dbname/username", resourceScalewayRdbPrivilegeID(scw.RegionFrPar, "instanceid", "dbname", "username"))
+	assert.Equal("fr-par/instanceid/*/username", resourceScalewayRdbPrivilegeID(scw.RegionFrPar, "instanceid", rdbPrivilegeAllDatabases, "username"))
+	assert.Equal("fr-par/instanceid/username", resourceScalewayRdbPrivilegeID(scw.RegionFrPar, "instanceid", "", "username"))
+}
+
+func TestRdbPrivilegeReconcilePermission(t *testing.T) {
+	assert := assert.New(t)
+	permissions := map[string]string{
+		"bar": "readonly",
+		"foo": "readonly",
+		"qux": "all",
+	}
+
+	assert.Equal("readonly", rdbPrivilegeReconcilePermission("readonly", []string{"foo", "bar"}, permissions))
+	assert.Equal("all", rdbPrivilegeReconcilePermission("readonly", []string{"qux", "foo", "bar"}, permissions))
+	assert.Equal("none", rdbPrivilegeReconcilePermission("readonly", []string{"foo", "new"}, permissions))
+	assert.Equal("readonly", rdbPrivilegeReconcilePermission("", []string{"foo", "bar"}, permissions))
+	assert.Equal("readonly", rdbPrivilegeReconcilePermission("readonly", nil, permissions))
+}
+
+func TestRdbPrivilegeV1SchemaUpgradeFunc(t *testing.T) {
+	v0Schema := map[string]interface{}{
+		"id":            "fr-par/22c61530-834c-4ab4-aa71-aaaa2ac9d45a",
+		"database_name": "foo",
+		"user_name":     "bar",
+	}
+	v1Schema := map[string]interface{}{
+		"id":            "fr-par/22c61530-834c-4ab4-aa71-aaaa2ac9d45a/foo/bar",
+		"database_name": "foo",
+		"user_name":     "bar",
+	}
+
+	actual, err := rdbPrivilegeV1SchemaUpgradeFunc(context.Background(), v0Schema, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(v1Schema, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v1Schema, actual)
+	}
+}
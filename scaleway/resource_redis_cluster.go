@@ -175,6 +175,11 @@ func resourceScalewayRedisCluster() *schema.Resource {
 				Computed:    true,
 				Description: "public TLS certificate used by redis cluster, empty if tls is disabled",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the Redis cluster, reflects the progress of a migration",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -284,6 +289,7 @@ func resourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceDat
 	_ = d.Set("project_id", cluster.ProjectID)
 	_ = d.Set("version", cluster.Version)
 	_ = d.Set("cluster_size", int(cluster.ClusterSize))
+	_ = d.Set("status", cluster.Status.String())
 	_ = d.Set("created_at", cluster.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", cluster.UpdatedAt.Format(time.RFC3339))
 	_ = d.Set("acl", flattenRedisACLs(cluster.ACLRules))
@@ -400,7 +406,7 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 			return diag.FromErr(err)
 		}
 
-		_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		err = waitForRedisClusterMigration(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
 		}
@@ -2,12 +2,14 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
 	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -29,6 +31,7 @@ func resourceScalewayVPCGatewayNetwork() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultVPCGatewayTimeout),
 		},
 		SchemaVersion: 0,
+		CustomizeDiff: resourceScalewayVPCGatewayNetworkCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Type:         schema.TypeString,
@@ -93,6 +96,52 @@ func resourceScalewayVPCGatewayNetwork() *schema.Resource {
 	}
 }
 
+// resourceScalewayVPCGatewayNetworkCustomizeDiff checks the static address against the DHCP setting and the private network subnets.
+func resourceScalewayVPCGatewayNetworkCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	staticAddress := diff.Get("static_address").(string)
+	if staticAddress == "" || !diff.NewValueKnown("static_address") {
+		return nil
+	}
+
+	// enable_dhcp defaults to true, only reject it when it is explicitly enabled
+	if enableDHCP := diff.GetRawConfig().GetAttr("enable_dhcp"); enableDHCP.IsKnown() && !enableDHCP.IsNull() && enableDHCP.True() {
+		return fmt.Errorf("static_address %s can only be used when enable_dhcp is false", staticAddress)
+	}
+
+	if !diff.HasChanges("static_address", "private_network_id") || !diff.NewValueKnown("private_network_id") {
+		return nil
+	}
+
+	vpcAPI, zone, privateNetworkID, err := vpcAPIWithZoneAndID(meta, diff.Get("private_network_id").(string))
+	if err != nil {
+		// the zone can't be resolved from a plain private network ID, the API will check the address
+		return nil
+	}
+
+	return vpcGatewayNetworkCheckStaticAddress(ctx, vpcAPI, zone, privateNetworkID, staticAddress)
+}
+
+// vpcGatewayNetworkCheckStaticAddress checks that the static address belongs to the subnets of the private network.
+func vpcGatewayNetworkCheckStaticAddress(ctx context.Context, vpcAPI *vpc.API, zone scw.Zone, privateNetworkID string, staticAddress string) error {
+	privateNetwork, err := vpcAPI.GetPrivateNetwork(&vpc.GetPrivateNetworkRequest{
+		Zone:             zone,
+		PrivateNetworkID: privateNetworkID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	contains, err := vpcSubnetsContainAddress(privateNetwork.Subnets, staticAddress)
+	if err != nil {
+		return err
+	}
+	if !contains {
+		return fmt.Errorf("static_address %s is not in the subnets of private network %s", staticAddress, privateNetwork.ID)
+	}
+
+	return nil
+}
+
 func resourceScalewayVPCGatewayNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -236,3 +237,23 @@ func testAccCheckScalewayVPCGatewayNetworkDestroy(tt *TestTools) resource.TestCh
 		return nil
 	}
 }
+
+func TestVPCSubnetsContainAddress(t *testing.T) {
+	subnet, err := expandIPNet("192.168.1.0/24")
+	assert.NoError(t, err)
+
+	contains, err := vpcSubnetsContainAddress([]scw.IPNet{subnet}, "192.168.1.42/24")
+	assert.NoError(t, err)
+	assert.True(t, contains)
+
+	contains, err = vpcSubnetsContainAddress([]scw.IPNet{subnet}, "10.0.0.1/24")
+	assert.NoError(t, err)
+	assert.False(t, contains)
+
+	contains, err = vpcSubnetsContainAddress(nil, "10.0.0.1/24")
+	assert.NoError(t, err)
+	assert.True(t, contains)
+
+	_, err = vpcSubnetsContainAddress([]scw.IPNet{subnet}, "not an address")
+	assert.Error(t, err)
+}
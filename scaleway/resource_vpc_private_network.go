@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -35,6 +36,43 @@ func resourceScalewayVPCPrivateNetwork() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"ipv4_subnet": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The IPv4 subnet of the private network",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDRNetwork(0, 32),
+							Description:  "The subnet CIDR",
+						},
+					},
+				},
+			},
+			"ipv6_subnets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The IPv6 subnets of the private network",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDRNetwork(0, 128),
+							Description:  "The subnet CIDR",
+						},
+					},
+				},
+			},
 			"project_id": projectIDSchema(),
 			"zone":       zoneSchema(),
 			// Computed elements
@@ -59,10 +97,16 @@ func resourceScalewayVPCPrivateNetworkCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	subnets, err := expandVPCPrivateNetworkSubnets(d.Get("ipv4_subnet"), d.Get("ipv6_subnets"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	pn, err := vpcAPI.CreatePrivateNetwork(&vpc.CreatePrivateNetworkRequest{
 		Name:      expandOrGenerateString(d.Get("name"), "pn"),
 		Tags:      expandStrings(d.Get("tags")),
 		ProjectID: d.Get("project_id").(string),
+		Subnets:   subnets,
 		Zone:      zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -100,7 +144,14 @@ func resourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.Resour
 	_ = d.Set("zone", zone)
 	_ = d.Set("tags", pn.Tags)
 
-	return nil
+	ipv4Subnet, ipv6Subnets, diags := flattenVPCPrivateNetworkSubnets(pn.Subnets)
+	if diags.HasError() {
+		return diags
+	}
+	_ = d.Set("ipv4_subnet", ipv4Subnet)
+	_ = d.Set("ipv6_subnets", ipv6Subnets)
+
+	return diags
 }
 
 func resourceScalewayVPCPrivateNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -165,3 +167,34 @@ func testAccCheckScalewayVPCPrivateNetworkDestroy(tt *TestTools) resource.TestCh
 		return nil
 	}
 }
+
+func TestVPCPrivateNetworkSubnets(t *testing.T) {
+	subnets, err := expandVPCPrivateNetworkSubnets(
+		[]interface{}{map[string]interface{}{"subnet": "172.16.32.0/22"}},
+		[]interface{}{map[string]interface{}{"subnet": "fd46:78ab:30b8:177c::/64"}},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, subnets, 2)
+	assert.Equal(t, "172.16.32.0/22", subnets[0].String())
+	assert.Equal(t, "fd46:78ab:30b8:177c::/64", subnets[1].String())
+
+	subnets, err = expandVPCPrivateNetworkSubnets([]interface{}{}, []interface{}{})
+	assert.NoError(t, err)
+	assert.Empty(t, subnets)
+
+	ipv4SubnetA, _ := expandIPNet("172.16.32.0/22")
+	ipv4SubnetB, _ := expandIPNet("172.16.64.0/22")
+	ipv6Subnet, _ := expandIPNet("fd46:78ab:30b8:177c::/64")
+	ipv4Subnet, ipv6Subnets, diags := flattenVPCPrivateNetworkSubnets([]scw.IPNet{ipv6Subnet, ipv4SubnetA})
+	assert.Empty(t, diags)
+	assert.Equal(t, []map[string]interface{}{{"subnet": "172.16.32.0/22"}}, ipv4Subnet)
+	assert.Equal(t, []map[string]interface{}{{"subnet": "fd46:78ab:30b8:177c::/64"}}, ipv6Subnets)
+
+	// extra IPv4 subnets are reported instead of being silently dropped
+	ipv4Subnet, _, diags = flattenVPCPrivateNetworkSubnets([]scw.IPNet{ipv6Subnet, ipv4SubnetA, ipv4SubnetB})
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, "172.16.64.0/22")
+	assert.Equal(t, []map[string]interface{}{{"subnet": "172.16.32.0/22"}}, ipv4Subnet)
+}
@@ -74,6 +74,11 @@ func resourceScalewayVPCPublicGateway() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"refresh_ssh_keys": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Trigger a refresh of the SSH keys of the bastion by changing this field's value",
+			},
 			"enable_smtp": {
 				Type:        schema.TypeBool,
 				Description: "Enable SMTP on the gateway",
@@ -220,6 +225,21 @@ func resourceScalewayVPCPublicGatewayUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("refresh_ssh_keys") {
+		_, err = vpcgwAPI.RefreshSSHKeys(&vpcgw.RefreshSSHKeysRequest{
+			Zone:      zone,
+			GatewayID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForVPCPublicGateway(ctx, vpcgwAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayVPCPublicGatewayRead(ctx, d, meta)
 }
 
@@ -0,0 +1,156 @@
+package scaleway
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayVPCPublicGatewayDHCPReservations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayVPCPublicGatewayDHCPReservationsCreate,
+		ReadContext:   resourceScalewayVPCPublicGatewayDHCPReservationsRead,
+		UpdateContext: resourceScalewayVPCPublicGatewayDHCPReservationsUpdate,
+		DeleteContext: resourceScalewayVPCPublicGatewayDHCPReservationsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
+			Update:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
+			Delete:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
+			Default: schema.DefaultTimeout(defaultVPCGatewayTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"gateway_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the owning GatewayNetwork",
+			},
+			"entry": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The DHCP reservations of the gateway network, any other reservation is removed",
+				Set:         vpcPublicGatewayDHCPReservationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The MAC address to give a static entry to",
+							ValidateFunc: validation.IsMACAddress,
+							StateFunc:    normalizeMACAddress,
+						},
+						"ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The IP address to give to the machine (IPv4 address)",
+							ValidateFunc: validation.IsIPAddress,
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func resourceScalewayVPCPublicGatewayDHCPReservationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, zone, err := vpcgwAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	gatewayNetworkZonedID := datasourceNewZonedID(d.Get("gateway_network_id"), zone)
+	d.SetId(gatewayNetworkZonedID)
+
+	// We call update instead of read as it will take care of setting the reservations.
+	return resourceScalewayVPCPublicGatewayDHCPReservationsUpdate(ctx, d, meta)
+}
+
+func resourceScalewayVPCPublicGatewayDHCPReservationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, gatewayNetworkID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := vpcgwAPI.ListDHCPEntries(&vpcgw.ListDHCPEntriesRequest{
+		Zone:             zone,
+		GatewayNetworkID: &gatewayNetworkID,
+		Type:             vpcgw.DHCPEntryTypeReservation,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("gateway_network_id", newZonedIDString(zone, gatewayNetworkID))
+	_ = d.Set("entry", flattenVPCPublicGatewayDHCPReservations(res.DHCPEntries))
+	_ = d.Set("zone", zone)
+
+	return nil
+}
+
+func resourceScalewayVPCPublicGatewayDHCPReservationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, gatewayNetworkID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries, err := expandVPCPublicGatewayDHCPReservations(d.Get("entry"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setVPCPublicGatewayDHCPReservations(ctx, vpcgwAPI, zone, gatewayNetworkID, entries, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayVPCPublicGatewayDHCPReservationsRead(ctx, d, meta)
+}
+
+func resourceScalewayVPCPublicGatewayDHCPReservationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, gatewayNetworkID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setVPCPublicGatewayDHCPReservations(ctx, vpcgwAPI, zone, gatewayNetworkID, []*vpcgw.SetDHCPEntriesRequestEntry{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setVPCPublicGatewayDHCPReservations replaces all the reservations of a gateway network.
+func setVPCPublicGatewayDHCPReservations(ctx context.Context, vpcgwAPI *vpcgw.API, zone scw.Zone, gatewayNetworkID string, entries []*vpcgw.SetDHCPEntriesRequestEntry, timeout time.Duration) error {
+	_, err := waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = vpcgwAPI.SetDHCPEntries(&vpcgw.SetDHCPEntriesRequest{
+		Zone:             zone,
+		GatewayNetworkID: gatewayNetworkID,
+		DHCPEntries:      entries,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, timeout)
+	return err
+}
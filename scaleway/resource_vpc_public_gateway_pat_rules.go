@@ -0,0 +1,172 @@
+package scaleway
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayVPCPublicGatewayPATRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayVPCPublicGatewayPATRulesCreate,
+		ReadContext:   resourceScalewayVPCPublicGatewayPATRulesRead,
+		UpdateContext: resourceScalewayVPCPublicGatewayPATRulesUpdate,
+		DeleteContext: resourceScalewayVPCPublicGatewayPATRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
+			Update:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
+			Delete:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
+			Default: schema.DefaultTimeout(defaultVPCGatewayTimeout),
+		},
+		CustomizeDiff: resourceScalewayVPCPublicGatewayPATRulesCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the gateway the PAT rules are applied to",
+			},
+			"rule": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The PAT rules of the gateway, any other rule is removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, math.MaxUint16),
+							Description:  "The public port used in the PAT rule",
+						},
+						"private_ip": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+							Description:  "The private IP used in the PAT rule",
+						},
+						"private_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, math.MaxUint16),
+							Description:  "The private port used in the PAT rule",
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  vpcgw.PATRuleProtocolBoth.String(),
+							ValidateFunc: validation.StringInSlice([]string{
+								vpcgw.PATRuleProtocolTCP.String(),
+								vpcgw.PATRuleProtocolUDP.String(),
+								vpcgw.PATRuleProtocolBoth.String(),
+							}, false),
+							Description: "The protocol used in the PAT rule",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+// resourceScalewayVPCPublicGatewayPATRulesCustomizeDiff rejects public ports forwarded more than once for the same protocol.
+// The raw configuration is used as rules only differing by their private ip or port are distinct elements of the set.
+func resourceScalewayVPCPublicGatewayPATRulesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return validateVPCPublicGatewayPATRules(expandVPCPublicGatewayPATRulesRawConfig(diff.GetRawConfig().GetAttr("rule")))
+}
+
+func resourceScalewayVPCPublicGatewayPATRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, zone, err := vpcgwAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datasourceNewZonedID(d.Get("gateway_id"), zone))
+
+	// We call update instead of read as it will take care of setting the rules.
+	return resourceScalewayVPCPublicGatewayPATRulesUpdate(ctx, d, meta)
+}
+
+func resourceScalewayVPCPublicGatewayPATRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, gatewayID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := vpcgwAPI.ListPATRules(&vpcgw.ListPATRulesRequest{
+		Zone:      zone,
+		GatewayID: &gatewayID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("gateway_id", newZonedIDString(zone, gatewayID))
+	_ = d.Set("rule", flattenVPCPublicGatewayPATRules(res.PatRules))
+	_ = d.Set("zone", zone)
+
+	return nil
+}
+
+func resourceScalewayVPCPublicGatewayPATRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, gatewayID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setVPCPublicGatewayPATRules(ctx, vpcgwAPI, zone, gatewayID, expandVPCPublicGatewayPATRules(d.Get("rule")), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayVPCPublicGatewayPATRulesRead(ctx, d, meta)
+}
+
+func resourceScalewayVPCPublicGatewayPATRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, gatewayID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setVPCPublicGatewayPATRules(ctx, vpcgwAPI, zone, gatewayID, []*vpcgw.SetPATRulesRequestRule{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !is404Error(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setVPCPublicGatewayPATRules replaces all the PAT rules of a gateway.
+func setVPCPublicGatewayPATRules(ctx context.Context, vpcgwAPI *vpcgw.API, zone scw.Zone, gatewayID string, rules []*vpcgw.SetPATRulesRequestRule, timeout time.Duration) error {
+	_, err := waitForVPCPublicGateway(ctx, vpcgwAPI, zone, gatewayID, timeout)
+	if err != nil {
+		return err
+	}
+
+	_, err = vpcgwAPI.SetPATRules(&vpcgw.SetPATRulesRequest{
+		Zone:      zone,
+		GatewayID: gatewayID,
+		PatRules:  rules,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForVPCPublicGateway(ctx, vpcgwAPI, zone, gatewayID, timeout)
+	return err
+}